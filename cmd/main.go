@@ -3,9 +3,14 @@ package main
 import (
 	// internal
 	"github.com/Vy4cheSlave/qna/internal/config"
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/health"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/db"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/token"
 	"github.com/Vy4cheSlave/qna/internal/logpack"
+	"github.com/Vy4cheSlave/qna/internal/metrics"
+	"github.com/Vy4cheSlave/qna/internal/tracing"
 	"github.com/Vy4cheSlave/qna/internal/usecase"
 	// external
 	"github.com/joho/godotenv"
@@ -16,10 +21,13 @@ import (
 	// "fmt"
 	"log"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 )
 
 func main() {
@@ -27,6 +35,22 @@ func main() {
 		log.Fatal("Ошибка загрузки env файла:", err)
 	}
 
+	// Команда migrate управляет схемой базы данных и не запускает сервер, поэтому
+	// читает только настройки PostgreSQL и не требует остальных параметров сервера
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		var dbCfg config.PostgreSQL
+		if err := envconfig.Process("", &dbCfg); err != nil {
+			log.Fatal(errors.Wrap(err, "failed to load database configuration"))
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		err := runMigrate(ctx, dbCfg, os.Stdout, os.Args[2:])
+		stop()
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	// Загрузка конфигурарции из переменных окружения
 	var cfg config.AppConfig
 	if err := envconfig.Process("", &cfg); err != nil {
@@ -38,31 +62,188 @@ func main() {
 	if err != nil {
 		log.Fatal(errors.Wrap(err, "error initializing logger"))
 	}
+
 	restAddr := strings.Join([]string{cfg.Rest.Host, cfg.Rest.Port}, ":")
 
+	// Инициализация трассировки до подключения к базе данных, чтобы запросы
+	// репозитория попадали в трассировки
+	tracerProvider, err := tracing.NewProvider(context.Background(), cfg.Tracing)
+	if err != nil {
+		log.Fatal(errors.Wrap(err, "error initializing tracing"))
+	}
+
 	// Подключение к базе данных
 	repo, err := db.NewRepository(context.Background(), cfg.PostgreSQL)
 	if err != nil {
 		log.Fatal(errors.Wrap(err, "error initializing repository"))
 	}
 
+	// Применение миграций при запуске; экземпляры, запущенные одновременно,
+	// дожидаются друг друга на advisory-блокировке
+	migrator, err := db.NewMigrator(repo)
+	if err != nil {
+		log.Fatal(errors.Wrap(err, "error initializing migrator"))
+	}
+	if cfg.Migrations.AutoMigrate {
+		results, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatal(errors.Wrap(err, "error applying migrations"))
+		}
+		for _, result := range results {
+			logger.Info("migration applied", slog.String("migration", result.String()))
+		}
+	}
+
+	// Регистрация проверок состояния
+	healthRegistry := health.NewRegistry(cfg.Health.CheckTimeout)
+	healthRegistry.RegisterReadiness("postgres", health.CheckerFunc(repo.Ping))
+	healthRegistry.RegisterStartup("postgres", health.CheckerFunc(repo.Ping))
+	// схема должна быть не старее последней встроенной миграции
+	latestMigration := migrator.Latest()
+	healthRegistry.RegisterStartup("migrations", health.CheckerFunc(func(ctx context.Context) error {
+		version, err := repo.MigrationVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if version < latestMigration {
+			return errors.Errorf("database schema version %d is behind expected %d", version, latestMigration)
+		}
+		return nil
+	}))
+
+	// Регистрация метрик
+	metricsRegistry := metrics.NewRegistry()
+	sqlDB, err := repo.SQLDB()
+	if err != nil {
+		log.Fatal(errors.Wrap(err, "error initializing metrics"))
+	}
+	metricsRegistry.RegisterDB(cfg.PostgreSQL.Name, sqlDB)
+	metricsRegistry.RegisterEntityTotals(repo, cfg.Metrics.QueryTimeout)
+
 	// Инициализация сервиса
-	service := usecase.NewQNAManagerService(repo, repo)
+	userDeletion := domain.UserDeletionPolicy(cfg.Users.DeletionPolicy)
+	if !userDeletion.Valid() {
+		log.Fatal(errors.Errorf("unknown user deletion policy %q", cfg.Users.DeletionPolicy))
+	}
+	if cfg.Events.ReplayBufferSize < 0 {
+		log.Fatal(errors.Errorf("negative events replay buffer size %d", cfg.Events.ReplayBufferSize))
+	}
+	var eventBus usecase.EventBus
+	switch cfg.Events.Bus {
+	case "postgres":
+		eventBus = db.NewEventBus(repo, logger)
+	case "memory":
+		eventBus = usecase.NewMemoryEventBus()
+	default:
+		log.Fatal(errors.Errorf("unknown event bus %q", cfg.Events.Bus))
+	}
+	policy := usecase.NewPolicy(repo)
+	eventBroker := usecase.NewEventBroker(cfg.Events.ReplayBufferSize)
+	service := usecase.NewQNAManagerService(usecase.QNADeps{
+		QNAManager:   repo,
+		UserManager:  repo,
+		Searcher:     repo,
+		Trash:        repo,
+		Webhooks:     repo,
+		Policy:       policy,
+		UserDeletion: userDeletion,
+		Events:       eventBus,
+		Metrics:      metricsRegistry,
+	})
+	tokenManager := token.NewManager([]byte(cfg.Auth.TokenSecret), cfg.Auth.AccessTokenTTL)
+	authService := usecase.NewAuthService(repo, tokenManager, cfg.Auth.RefreshTokenTTL)
 
-	// Запуск HTTP-сервера в отдельной горутине
-	app := rest.NewApp(logger, &restAddr, service)
-	go func() {
-		err := app.ServerInstance.Run()
+	// Запуск фоновых задач: очистки корзины, приема событий всех экземпляров
+	// и отправки вебхуков
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	var background sync.WaitGroup
+	purger := usecase.NewPurger(repo, cfg.Trash.Retention, cfg.Trash.PurgeInterval, logger)
+	background.Go(func() {
+		purger.Run(backgroundCtx)
+	})
+	background.Go(func() {
+		err := eventBus.Listen(backgroundCtx, func(event domain.Event) {
+			eventBroker.Publish(backgroundCtx, event)
+		})
 		if err != nil {
-			log.Fatal(errors.Wrap(err, "failed to start server"))
+			logger.Error("event listener stopped", slog.String("error", err.Error()))
 		}
+	})
+	webhookSender := usecase.NewWebhookSender(
+		repo,
+		&http.Client{Timeout: cfg.Webhooks.Timeout},
+		cfg.Webhooks.Workers,
+		cfg.Webhooks.PollInterval,
+		cfg.Webhooks.MaxAttempts,
+		cfg.Webhooks.RetryDelay,
+		logger,
+	)
+	background.Go(func() {
+		webhookSender.Run(backgroundCtx)
+	})
+
+	// Запуск HTTP-сервера в отдельной горутине
+	app := rest.NewApp(rest.ServerDeps{
+		Log:               logger,
+		Service:           service,
+		Auth:              authService,
+		Tokens:            tokenManager,
+		Addr:              &restAddr,
+		ShutdownTimeout:   cfg.Rest.ShutdownTimeout,
+		Health:            healthRegistry,
+		Metrics:           metricsRegistry,
+		Events:            eventBroker,
+		HeartbeatInterval: cfg.Events.HeartbeatInterval,
+	})
+	serverErrChan := make(chan error, 1)
+	go func() {
+		serverErrChan <- app.ServerInstance.Run()
 	}()
 
 	// Ожидание системных сигналов для корректного завершения работы
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
-	signalFromChannel := <-signalChan
+	// serverFailed означает аварийную остановку сервера: после освобождения
+	// ресурсов процесс завершается с ненулевым кодом
+	serverFailed := false
+	select {
+	case signalFromChannel := <-signalChan:
+		logger.Info("Shutting down server...", slog.String("signal", signalFromChannel.String()))
+	case err := <-serverErrChan:
+		if err != nil {
+			serverFailed = true
+			logger.Error("Server stopped unexpectedly", slog.String("error", err.Error()))
+		}
+	}
+
+	// Сначала сообщаем балансировщику, что сервер не готов, и даем ему время
+	// перестать направлять новые запросы; упавший сервер запросы уже не принимает
+	app.ServerInstance.SetReady(false)
+	if !serverFailed {
+		time.Sleep(cfg.Rest.ShutdownDelay)
+	}
+
+	// Дожидаемся завершения текущих запросов
+	if err := app.ServerInstance.Shutdown(context.Background()); err != nil {
+		logger.Error("failed to shutdown server", slog.String("error", err.Error()))
+	}
+
+	// Останавливаем фоновые задачи до закрытия пула соединений
+	stopBackground()
+	background.Wait()
 
-	logger.Info("Shutting down server...", slog.String("signal", signalFromChannel.String()))
+	// Закрываем пул соединений с базой данных после остановки сервера
+	if err := repo.Close(); err != nil {
+		logger.Error("failed to close repository", slog.String("error", err.Error()))
+	}
+
+	// Выгружаем накопленные span перед выходом
+	if err := tracerProvider.Shutdown(context.Background()); err != nil {
+		logger.Error("failed to shutdown tracing", slog.String("error", err.Error()))
+	}
+
+	if serverFailed {
+		os.Exit(1)
+	}
 	logger.Info("Shutting down gracefully...")
 }
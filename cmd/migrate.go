@@ -0,0 +1,102 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/config"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db"
+	// external
+	"github.com/pkg/errors"
+	"github.com/pressly/goose/v3"
+	// std
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+const migrateUsage = "usage: main migrate up|down|status|redo|version"
+
+var migrateCommands = []string{"up", "down", "status", "redo", "version"}
+
+// runMigrate выполняет команду migrate над встроенными миграциями и выводит
+// результат в out. Сервер при этом не запускается.
+func runMigrate(ctx context.Context, cfg config.PostgreSQL, out io.Writer, args []string) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+	if !slices.Contains(migrateCommands, args[0]) {
+		return errors.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+
+	repo, err := db.NewRepository(ctx, cfg)
+	if err != nil {
+		return errors.Wrap(err, "error initializing repository")
+	}
+	defer repo.Close()
+
+	migrator, err := db.NewMigrator(repo)
+	if err != nil {
+		return errors.Wrap(err, "error initializing migrator")
+	}
+
+	switch args[0] {
+	case "up":
+		results, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			fmt.Fprintln(out, "no migrations to apply")
+		}
+		printMigrationResults(out, results...)
+	case "down":
+		result, err := migrator.Down(ctx)
+		if errors.Is(err, goose.ErrNoNextVersion) {
+			return errors.New("no migrations to roll back")
+		}
+		if err != nil {
+			return err
+		}
+		printMigrationResults(out, result)
+	case "redo":
+		results, err := migrator.Redo(ctx)
+		printMigrationResults(out, results...)
+		if errors.Is(err, goose.ErrNoNextVersion) {
+			return errors.New("no migrations to redo")
+		}
+		if err != nil {
+			return err
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "APPLIED AT\tMIGRATION")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.State == goose.StateApplied {
+				appliedAt = status.AppliedAt.UTC().Format(time.DateTime)
+			}
+			fmt.Fprintf(writer, "%s\t%s\n", appliedAt, status.Source.Path)
+		}
+		return writer.Flush()
+	case "version":
+		version, err := migrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "version %d, latest embedded %d\n", version, migrator.Latest())
+	}
+
+	return nil
+}
+
+func printMigrationResults(out io.Writer, results ...*goose.MigrationResult) {
+	for _, result := range results {
+		fmt.Fprintln(out, result)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/config"
+	// external
+	"github.com/kelseyhightower/envconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	// std
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRunMigrateUsage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "no command", args: nil, expected: migrateUsage},
+		{name: "extra arguments", args: []string{"up", "now"}, expected: migrateUsage},
+		{name: "unknown command", args: []string{"sideways"}, expected: "unknown migrate command \"sideways\"\n" + migrateUsage},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// команда отклоняется до подключения к базе данных
+			var out bytes.Buffer
+			err := runMigrate(context.Background(), config.PostgreSQL{Host: "127.0.0.1", Port: 1}, &out, tt.args)
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, err.Error())
+			assert.Empty(t, out.String())
+		})
+	}
+}
+
+// TestRunMigrate выполняет команды на базе из переменных DB_* и запускается
+// только при TEST_POSTGRES=1 на отдельной базе.
+func TestRunMigrate(t *testing.T) {
+	if os.Getenv("TEST_POSTGRES") != "1" {
+		t.Skip("set TEST_POSTGRES=1 and DB_* variables of a disposable database to run")
+	}
+
+	var cfg config.PostgreSQL
+	require.NoError(t, envconfig.Process("", &cfg))
+
+	run := func(t *testing.T, command string) string {
+		t.Helper()
+		var out bytes.Buffer
+		require.NoError(t, runMigrate(context.Background(), cfg, &out, []string{command}))
+		return out.String()
+	}
+
+	run(t, "up")
+	assert.Equal(t, "no migrations to apply\n", run(t, "up"))
+
+	version := run(t, "version")
+	var current, latest int64
+	_, err := fmt.Sscanf(version, "version %d, latest embedded %d\n", &current, &latest)
+	require.NoError(t, err)
+	assert.Equal(t, latest, current)
+
+	status := strings.Split(strings.TrimSpace(run(t, "status")), "\n")
+	assert.True(t, strings.HasPrefix(status[0], "APPLIED AT"))
+	for _, line := range status[1:] {
+		assert.NotContains(t, line, "pending")
+	}
+
+	// redo откатывает и заново применяет последнюю миграцию
+	redo := strings.Split(strings.TrimSpace(run(t, "redo")), "\n")
+	require.Len(t, redo, 2)
+	for _, line := range redo {
+		assert.Contains(t, line, fmt.Sprint(latest))
+	}
+	assert.Equal(t, version, run(t, "version"))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/pkg/client"
+	// external
+	"github.com/spf13/cobra"
+)
+
+func (a *app) answersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "answers",
+		Short: "Ответы",
+	}
+	cmd.AddCommand(a.getAnswerCommand(), a.createAnswerCommand(), a.deleteAnswerCommand())
+	return cmd
+}
+
+func (a *app) getAnswerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get ID",
+		Short: "Ответ",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			answerId, err := parseId(args[0])
+			if err != nil {
+				return err
+			}
+			answer, err := a.client.GetAnswer(cmd.Context(), answerId)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"ID", "QUESTION_ID", "SCORE", "AUTHOR", "TEXT"}}
+			tbl.add(answer.Id, answer.QuestionId, answer.Score, answer.UserId, truncate(answer.Text))
+			return render(a.out, a.output, answer, tbl)
+		},
+	}
+}
+
+func (a *app) createAnswerCommand() *cobra.Command {
+	var answer client.Answer
+
+	cmd := &cobra.Command{
+		Use:   "create QUESTION_ID",
+		Short: "Ответить на вопрос",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			answer.QuestionId, err = parseId(args[0])
+			if err != nil {
+				return err
+			}
+			answerId, err := a.client.CreateAnswerToQuestion(cmd.Context(), &answer)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"ANSWER_ID"}}
+			tbl.add(answerId)
+			return render(a.out, a.output, response.CreateAnswerToQuestionResponse{AnswerId: answerId}, tbl)
+		},
+	}
+	cmd.Flags().StringVar(&answer.Text, "text", "", "текст ответа")
+	cmd.Flags().StringVar(&answer.UserId, "user", "", "автор ответа (по умолчанию владелец токена)")
+	_ = cmd.MarkFlagRequired("text")
+	return cmd
+}
+
+func (a *app) deleteAnswerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete ID",
+		Short: "Удалить ответ в корзину",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			answerId, err := parseId(args[0])
+			if err != nil {
+				return err
+			}
+			return a.client.DeleteAnswer(cmd.Context(), answerId)
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	// external
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	// std
+	"os"
+	"path/filepath"
+)
+
+// config - файл конфигурации qnactl с адресом API и access-токеном.
+type config struct {
+	Server string `yaml:"server,omitempty"`
+	Token  string `yaml:"token,omitempty"`
+}
+
+func defaultConfigHint() string {
+	return filepath.Join("$XDG_CONFIG_HOME", "qnactl", "config.yaml")
+}
+
+// resolveConfigPath возвращает путь к файлу конфигурации: заданный флагом,
+// QNACTL_CONFIG или файл в каталоге конфигурации пользователя.
+func resolveConfigPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	if path := os.Getenv("QNACTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to locate config directory")
+	}
+	return filepath.Join(dir, "qnactl", "config.yaml"), nil
+}
+
+// loadConfig читает конфигурацию; отсутствующий файл равносилен пустой конфигурации.
+func loadConfig(path string) (*config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &config{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+
+	var cfg config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config %s", path)
+	}
+	return &cfg, nil
+}
+
+// saveConfig записывает конфигурацию; файл содержит токен и доступен только владельцу.
+func saveConfig(path string, cfg *config) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode config")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create config directory")
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write config")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/pkg/client"
+	// external
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	// std
+	"fmt"
+	"os"
+)
+
+// exportPageLimit - размер страницы при обходе вопросов для выгрузки
+const exportPageLimit = 100
+
+func (a *app) exportCommand() *cobra.Command {
+	var (
+		file string
+		tags []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Выгрузить вопросы с ответами в JSON или YAML",
+		Long: "Выгрузить все вопросы (или вопросы с тегами --tag) вместе с ответами.\n" +
+			"Каждый элемент имеет вид ответа GET /questions/{id}; формат table выгружается как json.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			threads := make([]response.GetQuestionAndAnswersResponse, 0)
+			filter := &client.QuestionFilter{Tags: tags}
+			if len(tags) > 0 {
+				filter.TagMatch = client.TagMatchAny
+			}
+			for question, err := range a.client.Questions(ctx, &client.ListParams{Limit: exportPageLimit, Order: client.SortAsc}, filter) {
+				if err != nil {
+					return err
+				}
+				_, answers, err := a.client.GetQuestionAndAnswers(ctx, question.Id)
+				// вопрос мог быть удален во время выгрузки
+				if errors.Is(err, client.ErrNotFound) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				threads = append(threads, response.GetQuestionAndAnswersResponse{Question: question, Answers: answers})
+			}
+
+			format := a.output
+			if format == formatTable {
+				format = formatJSON
+			}
+			if file == "" {
+				return render(a.out, format, threads, nil)
+			}
+
+			f, err := os.Create(file)
+			if err != nil {
+				return errors.Wrap(err, "failed to create export file")
+			}
+			if err := render(f, format, threads, nil); err != nil {
+				_ = f.Close()
+				return err
+			}
+			// ошибка записи на диск может проявиться только при закрытии файла
+			if err := f.Close(); err != nil {
+				return errors.Wrap(err, "failed to write export file")
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "exported %d questions to %s\n", len(threads), file)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&file, "file", "", "файл для выгрузки (по умолчанию стандартный вывод)")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "выгрузить только вопросы с любым из тегов")
+	return cmd
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	// external
+	"github.com/spf13/cobra"
+	// std
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+func (a *app) loginCommand() *cobra.Command {
+	var password string
+
+	cmd := &cobra.Command{
+		Use:   "login NAME",
+		Short: "Войти и сохранить access-токен в файл конфигурации",
+		Long: "Войти под пользователем NAME и сохранить адрес API и access-токен в файл конфигурации.\n" +
+			"Без --password пароль читается из первой строки стандартного ввода.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if password == "" {
+				fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+				line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if err != nil && line == "" {
+					return fmt.Errorf("failed to read password: %w", err)
+				}
+				password = strings.TrimRight(line, "\r\n")
+			}
+
+			tokens, err := a.client.Login(cmd.Context(), args[0], password)
+			if err != nil {
+				return err
+			}
+
+			if a.server != "" {
+				a.config.Server = a.server
+			}
+			a.config.Token = tokens.AccessToken
+			if err := saveConfig(a.configPath, a.config); err != nil {
+				return err
+			}
+			fmt.Fprintf(a.out, "Logged in as %s, token expires in %ds, saved to %s\n", args[0], tokens.ExpiresIn, a.configPath)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&password, "password", "", "пароль")
+	return cmd
+}
@@ -0,0 +1,108 @@
+// qnactl - клиент командной строки для HTTP API сервиса вопросов и ответов.
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/pkg/client"
+	// external
+	"github.com/spf13/cobra"
+	// std
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+const defaultServer = "http://localhost:8080"
+
+// app - общее состояние команд: глобальные флаги, конфигурация и клиент API.
+type app struct {
+	configPath string
+	server     string
+	token      string
+	output     string
+
+	config *config
+	client *client.Client
+	out    io.Writer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := newRootCommand(os.Stdout).ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func newRootCommand(out io.Writer) *cobra.Command {
+	a := &app{out: out}
+
+	root := &cobra.Command{
+		Use:           "qnactl",
+		Short:         "Управление сервисом вопросов и ответов через HTTP API",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return a.init(cmd)
+		},
+	}
+	root.SetOut(out)
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.configPath, "config", "", "файл конфигурации (по умолчанию "+defaultConfigHint()+")")
+	flags.StringVar(&a.server, "server", "", "адрес API (по умолчанию из конфигурации или "+defaultServer+")")
+	flags.StringVar(&a.token, "token", "", "access-токен (по умолчанию из конфигурации)")
+	flags.StringVarP(&a.output, "output", "o", formatTable, "формат вывода: table, json или yaml")
+	_ = root.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(formats, cobra.ShellCompDirectiveNoFileComp))
+
+	root.AddCommand(
+		a.loginCommand(),
+		a.usersCommand(),
+		a.questionsCommand(),
+		a.answersCommand(),
+		a.searchCommand(),
+		a.exportCommand(),
+	)
+	return root
+}
+
+// init загружает конфигурацию и создает клиент; флаги важнее конфигурации.
+func (a *app) init(cmd *cobra.Command) error {
+	if !isFormat(a.output) {
+		return fmt.Errorf("unknown output format %q", a.output)
+	}
+	// генерация скриптов автодополнения не обращается к API
+	if cmd.Name() == "completion" || (cmd.HasParent() && cmd.Parent().Name() == "completion") {
+		return nil
+	}
+
+	configPath, err := resolveConfigPath(a.configPath)
+	if err != nil {
+		return err
+	}
+	a.configPath = configPath
+	a.config, err = loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	server := a.server
+	if server == "" {
+		server = a.config.Server
+	}
+	if server == "" {
+		server = defaultServer
+	}
+	token := a.token
+	if token == "" {
+		token = a.config.Token
+	}
+
+	a.client, err = client.New(server, client.WithAccessToken(token))
+	return err
+}
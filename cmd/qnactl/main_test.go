@@ -0,0 +1,196 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/health"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/mocks"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/token"
+	// external
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	// std
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testUserId = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+
+// runCommand запускает qnactl против API на настоящих обработчиках rest с моками сервисов.
+func runCommand(t *testing.T, setupMock func(*mocks.MockQNADispatcher, *mocks.MockAuthDispatcher), args ...string) (string, error) {
+	tokens := token.NewManager([]byte("secret"), time.Minute)
+	accessToken, _, err := tokens.IssueAccessToken(&domain.Principal{UserId: testUserId})
+	require.NoError(t, err)
+
+	service := mocks.NewMockQNADispatcher(t)
+	auth := mocks.NewMockAuthDispatcher(t)
+	if setupMock != nil {
+		setupMock(service, auth)
+	}
+	addr := ""
+	server := rest.NewServer(rest.ServerDeps{
+		Log:             slog.New(slog.DiscardHandler),
+		Service:         service,
+		Auth:            auth,
+		Tokens:          tokens,
+		Addr:            &addr,
+		ShutdownTimeout: time.Second,
+		Health:          health.NewRegistry(time.Second),
+	})
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	// токен и адрес берутся из файла конфигурации, если не заданы флагами
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, saveConfig(configPath, &config{Server: httpServer.URL, Token: accessToken}))
+
+	var out bytes.Buffer
+	root := newRootCommand(&out)
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs(append([]string{"--config", configPath}, args...))
+	err = root.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestQuestionsList(t *testing.T) {
+	createdAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	questions := []domain.Question{
+		{Id: 1, UserId: testUserId, Title: "How to use\ngoroutines?", Tags: []string{"go"}, Status: domain.QuestionStatusOpen, Score: 3, CreatedAt: createdAt},
+	}
+	setupMock := func(service *mocks.MockQNADispatcher, _ *mocks.MockAuthDispatcher) {
+		service.On("GetQuestions", mock.Anything, mock.Anything, &domain.QuestionFilter{
+			Statuses: []domain.QuestionStatus{domain.QuestionStatusOpen},
+			Tags:     []string{"go"},
+		}).Return(&questions, &domain.Page{}, nil).Once()
+	}
+
+	testCases := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{
+			name:   "Table",
+			output: "table",
+			expected: "ID  STATUS  SCORE  TAGS  CREATED              TITLE\n" +
+				"1   open    3      go    2026-10-17 12:00:00  How to use goroutines?\n",
+		},
+		{
+			name:   "YAML",
+			output: "yaml",
+			expected: "- AcceptedAnswerId: null\n" +
+				"  CreatedAt: \"2026-10-17T12:00:00Z\"\n" +
+				"  Id: 1\n" +
+				"  Score: 3\n" +
+				"  Status: open\n" +
+				"  Tags:\n" +
+				"    - go\n" +
+				"  Text: \"\"\n" +
+				"  Title: |-\n" +
+				"    How to use\n" +
+				"    goroutines?\n" +
+				"  UserId: " + testUserId + "\n",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := runCommand(t, setupMock, "questions", "list", "--status", "open", "--tag", "go", "-o", tt.output)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
+
+func TestCreateAnswer(t *testing.T) {
+	out, err := runCommand(t, func(service *mocks.MockQNADispatcher, _ *mocks.MockAuthDispatcher) {
+		service.On("CreateAnswerToQuestion", mock.Anything, &domain.Answer{QuestionId: 7, Text: "use channels"}).
+			Return(3, nil).Once()
+	}, "answers", "create", "7", "--text", "use channels", "-o", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"answer_id": 3}`, out)
+}
+
+func TestErrorOutput(t *testing.T) {
+	_, err := runCommand(t, func(service *mocks.MockQNADispatcher, _ *mocks.MockAuthDispatcher) {
+		service.On("DeleteQuestionAndAnswers", mock.Anything, 7).Return(domain.ErrNotFound).Once()
+	}, "questions", "delete", "7")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404 NOT_FOUND: resource not found")
+
+	_, err = runCommand(t, nil, "questions", "delete", "abc")
+	assert.EqualError(t, err, `ID must be a positive integer, got "abc"`)
+}
+
+func TestExport(t *testing.T) {
+	createdAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	questions := []domain.Question{{Id: 1, Title: "first", CreatedAt: createdAt}, {Id: 2, Title: "deleted", CreatedAt: createdAt}}
+	answers := []domain.Answer{{Id: 5, QuestionId: 1, Text: "answer"}}
+	file := filepath.Join(t.TempDir(), "export.json")
+
+	_, err := runCommand(t, func(service *mocks.MockQNADispatcher, _ *mocks.MockAuthDispatcher) {
+		service.On("GetQuestions", mock.Anything, mock.MatchedBy(func(params *domain.ListParams) bool {
+			return params.Limit == exportPageLimit && params.Order == domain.SortAsc
+		}), &domain.QuestionFilter{}).Return(&questions, &domain.Page{}, nil).Once()
+		service.On("GetQuestionAndAnswers", mock.Anything, 1).Return(&questions[0], &answers, nil).Once()
+		// вопрос удален после получения списка и пропускается
+		service.On("GetQuestionAndAnswers", mock.Anything, 2).Return(nil, nil, domain.ErrNotFound).Once()
+	}, "export", "--file", file)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{
+		"question": {"Id": 1, "UserId": "", "Title": "first", "Text": "", "Score": 0, "Tags": null, "Status": "", "AcceptedAnswerId": null, "CreatedAt": "2026-10-17T12:00:00Z"},
+		"answers": [{"Id": 5, "QuestionId": 1, "UserId": "", "Text": "answer", "Score": 0}]
+	}]`, string(data))
+}
+
+func TestLogin(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "qnactl", "config.yaml")
+	tokens := token.NewManager([]byte("secret"), time.Minute)
+	auth := mocks.NewMockAuthDispatcher(t)
+	userName, password := "admin", "password"
+	auth.On("Login", mock.Anything, &userName, &password).Return(&domain.TokenPair{
+		AccessToken:          "access-token",
+		AccessTokenExpiresAt: time.Now().Add(time.Minute),
+		RefreshToken:         "refresh-token",
+	}, nil).Once()
+
+	addr := ""
+	server := rest.NewServer(rest.ServerDeps{
+		Log:             slog.New(slog.DiscardHandler),
+		Service:         mocks.NewMockQNADispatcher(t),
+		Auth:            auth,
+		Tokens:          tokens,
+		Addr:            &addr,
+		ShutdownTimeout: time.Second,
+		Health:          health.NewRegistry(time.Second),
+	})
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	var out bytes.Buffer
+	root := newRootCommand(&out)
+	root.SetErr(&bytes.Buffer{})
+	root.SetIn(strings.NewReader(password + "\n"))
+	root.SetArgs([]string{"--config", configPath, "--server", httpServer.URL, "login", userName})
+	require.NoError(t, root.ExecuteContext(context.Background()))
+
+	cfg, err := loadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, config{Server: httpServer.URL, Token: "access-token"}, *cfg)
+
+	info, err := os.Stat(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
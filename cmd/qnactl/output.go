@@ -0,0 +1,81 @@
+package main
+
+import (
+	// external
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	// std
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+var formats = []string{formatTable, formatJSON, formatYAML}
+
+func isFormat(format string) bool {
+	return slices.Contains(formats, format)
+}
+
+// table - представление результата для вывода в виде таблицы.
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+func (t *table) add(cells ...any) {
+	row := make([]string, 0, len(cells))
+	for _, cell := range cells {
+		row = append(row, fmt.Sprint(cell))
+	}
+	t.rows = append(t.rows, row)
+}
+
+// render выводит value в формате format. В JSON и YAML поля называются так
+// же, как в ответах API; для таблицы используется tbl.
+func render(out io.Writer, format string, value any, tbl *table) error {
+	switch format {
+	case formatJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case formatYAML:
+		return writeYAML(out, value)
+	default:
+		if tbl == nil {
+			return errors.Errorf("output format %q is not supported by this command", format)
+		}
+		writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, strings.Join(tbl.headers, "\t"))
+		for _, row := range tbl.rows {
+			fmt.Fprintln(writer, strings.Join(row, "\t"))
+		}
+		return writer.Flush()
+	}
+}
+
+// writeYAML кодирует value через JSON, чтобы ключи совпадали с именами полей API.
+func writeYAML(out io.Writer, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(generic); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
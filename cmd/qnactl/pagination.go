@@ -0,0 +1,58 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/pkg/client"
+	// external
+	"github.com/spf13/cobra"
+	// std
+	"context"
+	"fmt"
+	"iter"
+)
+
+// pageFlags - флаги постраничных списков.
+type pageFlags struct {
+	limit  int
+	cursor string
+	all    bool
+}
+
+func (p *pageFlags) register(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&p.limit, "limit", 0, "размер страницы (по умолчанию 20, не больше 100)")
+	cmd.Flags().StringVar(&p.cursor, "cursor", "", "курсор страницы из предыдущего вывода")
+	cmd.Flags().BoolVar(&p.all, "all", false, "вывести все страницы")
+}
+
+func (p *pageFlags) params() *client.ListParams {
+	return &client.ListParams{Limit: p.limit, Cursor: p.cursor}
+}
+
+// listItems возвращает одну страницу списка, а с --all - все элементы. Курсор
+// следующей страницы выводится в stderr, чтобы не смешиваться с данными.
+func listItems[T any](
+	cmd *cobra.Command,
+	page *pageFlags,
+	fetch func(ctx context.Context, params *client.ListParams) (*client.Page[T], error),
+	walk func(ctx context.Context, params *client.ListParams) iter.Seq2[T, error],
+) ([]T, error) {
+	if !page.all {
+		result, err := fetch(cmd.Context(), page.params())
+		if err != nil {
+			return nil, err
+		}
+		if result.NextCursor != "" {
+			fmt.Fprintf(cmd.ErrOrStderr(), "next page: --cursor %s\n", result.NextCursor)
+		}
+		return result.Items, nil
+	}
+
+	items := make([]T, 0)
+	for item, err := range walk(cmd.Context(), page.params()) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/pkg/client"
+	// external
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	// std
+	"context"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (a *app) questionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "questions",
+		Short: "Вопросы",
+	}
+	cmd.AddCommand(a.listQuestionsCommand(), a.getQuestionCommand(), a.createQuestionCommand(), a.deleteQuestionCommand())
+	return cmd
+}
+
+func (a *app) listQuestionsCommand() *cobra.Command {
+	var (
+		page     pageFlags
+		statuses []string
+		tags     []string
+		tagMatch string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Список вопросов",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter := &client.QuestionFilter{Tags: tags, TagMatch: client.TagMatch(tagMatch)}
+			for _, status := range statuses {
+				filter.Statuses = append(filter.Statuses, client.QuestionStatus(status))
+			}
+
+			questions, err := listItems(cmd, &page,
+				func(ctx context.Context, params *client.ListParams) (*client.Page[client.Question], error) {
+					return a.client.GetQuestions(ctx, params, filter)
+				},
+				func(ctx context.Context, params *client.ListParams) iter.Seq2[client.Question, error] {
+					return a.client.Questions(ctx, params, filter)
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"ID", "STATUS", "SCORE", "TAGS", "CREATED", "TITLE"}}
+			for _, question := range questions {
+				tbl.add(question.Id, question.Status, question.Score, strings.Join(question.Tags, ","),
+					question.CreatedAt.Format(time.DateTime), truncate(question.Title))
+			}
+			return render(a.out, a.output, questions, tbl)
+		},
+	}
+	page.register(cmd)
+	cmd.Flags().StringSliceVar(&statuses, "status", nil, "фильтр по состоянию: open, answered, closed, locked")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "фильтр по тегам")
+	cmd.Flags().StringVar(&tagMatch, "tag-match", "", "all - все теги (по умолчанию), any - любой из тегов")
+	_ = cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions([]string{
+		string(client.QuestionStatusOpen),
+		string(client.QuestionStatusAnswered),
+		string(client.QuestionStatusClosed),
+		string(client.QuestionStatusLocked),
+	}, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("tag-match", cobra.FixedCompletions([]string{
+		string(client.TagMatchAll),
+		string(client.TagMatchAny),
+	}, cobra.ShellCompDirectiveNoFileComp))
+	return cmd
+}
+
+func (a *app) getQuestionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get ID",
+		Short: "Вопрос с ответами",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			questionId, err := parseId(args[0])
+			if err != nil {
+				return err
+			}
+			question, answers, err := a.client.GetQuestionAndAnswers(cmd.Context(), questionId)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"KIND", "ID", "SCORE", "AUTHOR", "TEXT"}}
+			tbl.add("question", question.Id, question.Score, question.UserId, truncate(question.Title))
+			for _, answer := range answers {
+				tbl.add("answer", answer.Id, answer.Score, answer.UserId, truncate(answer.Text))
+			}
+			return render(a.out, a.output, response.GetQuestionAndAnswersResponse{Question: *question, Answers: answers}, tbl)
+		},
+	}
+}
+
+func (a *app) createQuestionCommand() *cobra.Command {
+	var question client.Question
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Создать вопрос от имени владельца токена",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			questionId, err := a.client.CreateQuestion(cmd.Context(), &question)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"QUESTION_ID"}}
+			tbl.add(questionId)
+			return render(a.out, a.output, response.CreateQuestionResponse{QuestionId: questionId}, tbl)
+		},
+	}
+	cmd.Flags().StringVar(&question.Title, "title", "", "заголовок (до 200 символов)")
+	cmd.Flags().StringVar(&question.Text, "text", "", "текст вопроса")
+	cmd.Flags().StringSliceVar(&question.Tags, "tag", nil, "теги (до 5)")
+	_ = cmd.MarkFlagRequired("title")
+	_ = cmd.MarkFlagRequired("text")
+	return cmd
+}
+
+func (a *app) deleteQuestionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete ID",
+		Short: "Удалить вопрос вместе с ответами в корзину",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			questionId, err := parseId(args[0])
+			if err != nil {
+				return err
+			}
+			return a.client.DeleteQuestionAndAnswers(cmd.Context(), questionId)
+		},
+	}
+}
+
+func parseId(value string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 1 {
+		return 0, errors.Errorf("ID must be a positive integer, got %q", value)
+	}
+	return id, nil
+}
+
+// truncate укорачивает текст для ячейки таблицы и заменяет переводы строк пробелами.
+func truncate(text string) string {
+	const maxRunes = 60
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+	return string(runes[:maxRunes-1]) + "…"
+}
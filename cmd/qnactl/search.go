@@ -0,0 +1,38 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/pkg/client"
+	// external
+	"github.com/spf13/cobra"
+	// std
+	"strconv"
+	"strings"
+)
+
+func (a *app) searchCommand() *cobra.Command {
+	var query client.SearchQuery
+
+	cmd := &cobra.Command{
+		Use:   "search QUERY...",
+		Short: "Полнотекстовый поиск по вопросам и ответам",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query.Text = strings.Join(args, " ")
+			hits, err := a.client.Search(cmd.Context(), &query)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"KIND", "ID", "QUESTION_ID", "RANK", "SNIPPET"}}
+			for _, hit := range hits {
+				tbl.add(hit.Kind, hit.Id, hit.QuestionId, strconv.FormatFloat(hit.Rank, 'f', 3, 64), truncate(hit.Snippet))
+			}
+			return render(a.out, a.output, hits, tbl)
+		},
+	}
+	cmd.Flags().StringVar(&query.Language, "lang", "", "язык запроса")
+	cmd.Flags().IntVar(&query.Limit, "limit", 0, "число результатов (по умолчанию 20, не больше 100)")
+	cmd.Flags().IntVar(&query.Offset, "offset", 0, "смещение")
+	return cmd
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	// internal
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	// external
+	"github.com/spf13/cobra"
+)
+
+func (a *app) usersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "Пользователи",
+	}
+	cmd.AddCommand(a.listUsersCommand(), a.createUserCommand(), a.deleteUserCommand())
+	return cmd
+}
+
+func (a *app) listUsersCommand() *cobra.Command {
+	var page pageFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Список пользователей",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, err := listItems(cmd, &page, a.client.GetUsers, a.client.Users)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"ID", "NAME", "ROLE"}}
+			for _, user := range users {
+				tbl.add(user.Id, user.Name, user.Role)
+			}
+			return render(a.out, a.output, users, tbl)
+		},
+	}
+	page.register(cmd)
+	return cmd
+}
+
+func (a *app) createUserCommand() *cobra.Command {
+	var password string
+
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Зарегистрировать пользователя",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userId, err := a.client.CreateUser(cmd.Context(), args[0], password)
+			if err != nil {
+				return err
+			}
+
+			tbl := &table{headers: []string{"USER_ID"}}
+			tbl.add(userId)
+			return render(a.out, a.output, response.CreateUserResponse{UserId: userId}, tbl)
+		},
+	}
+	cmd.Flags().StringVar(&password, "password", "", "пароль (от 8 до 72 символов)")
+	_ = cmd.MarkFlagRequired("password")
+	return cmd
+}
+
+func (a *app) deleteUserCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete ID",
+		Short: "Удалить пользователя",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.client.DeleteUser(cmd.Context(), args[0])
+		},
+	}
+}
@@ -10,11 +10,22 @@ type AppConfig struct {
 	LogLevel   string
 	Rest       Rest
 	PostgreSQL PostgreSQL
+	Migrations Migrations
+	Health     Health
+	Auth       Auth
+	Trash      Trash
+	Users      Users
+	Events     Events
+	Webhooks   Webhooks
+	Metrics    Metrics
+	Tracing    Tracing
 }
 
 type Rest struct {
-	Host string `envconfig:"HOST" required:"true" default:"localhost"`
-	Port string `envconfig:"PORT" required:"true"`
+	Host            string        `envconfig:"HOST" required:"true" default:"localhost"`
+	Port            string        `envconfig:"PORT" required:"true"`
+	ShutdownDelay   time.Duration `envconfig:"SHUTDOWN_DELAY" default:"5s"`
+	ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"15s"`
 }
 
 type PostgreSQL struct {
@@ -28,3 +39,61 @@ type PostgreSQL struct {
 	PoolMaxConnLifetime time.Duration `envconfig:"DB_POOL_MAX_CONN_LIFETIME" default:"180s"`
 	PoolMaxConnIdleTime time.Duration `envconfig:"DB_POOL_MAX_CONN_IDLE_TIME" default:"100s"`
 }
+
+type Migrations struct {
+	// AutoMigrate - применять миграции при запуске сервера; одновременно
+	// запущенные экземпляры ждут друг друга на advisory-блокировке
+	AutoMigrate bool `envconfig:"AUTO_MIGRATE" default:"false"`
+}
+
+type Health struct {
+	CheckTimeout time.Duration `envconfig:"HEALTH_CHECK_TIMEOUT" default:"2s"`
+}
+
+type Auth struct {
+	TokenSecret     string        `envconfig:"AUTH_TOKEN_SECRET" required:"true"`
+	AccessTokenTTL  time.Duration `envconfig:"AUTH_ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTokenTTL time.Duration `envconfig:"AUTH_REFRESH_TOKEN_TTL" default:"720h"`
+}
+
+type Trash struct {
+	Retention     time.Duration `envconfig:"TRASH_RETENTION" default:"720h"`
+	PurgeInterval time.Duration `envconfig:"TRASH_PURGE_INTERVAL" default:"1h"`
+}
+
+type Users struct {
+	// DeletionPolicy - cascade, anonymize или block
+	DeletionPolicy string `envconfig:"USER_DELETION_POLICY" default:"cascade"`
+}
+
+type Events struct {
+	// Bus - postgres (LISTEN/NOTIFY, несколько экземпляров) или memory (один экземпляр)
+	Bus               string        `envconfig:"EVENTS_BUS" default:"postgres"`
+	ReplayBufferSize  int           `envconfig:"EVENTS_REPLAY_BUFFER_SIZE" default:"1000"`
+	HeartbeatInterval time.Duration `envconfig:"EVENTS_HEARTBEAT_INTERVAL" default:"15s"`
+}
+
+type Webhooks struct {
+	Workers      int           `envconfig:"WEBHOOK_WORKERS" default:"4"`
+	PollInterval time.Duration `envconfig:"WEBHOOK_POLL_INTERVAL" default:"1s"`
+	Timeout      time.Duration `envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	// MaxAttempts - число попыток, после которого доставка считается неудавшейся
+	MaxAttempts int `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"10"`
+	// RetryDelay - пауза после первой неудачной попытки; каждая следующая вдвое длиннее
+	RetryDelay time.Duration `envconfig:"WEBHOOK_RETRY_DELAY" default:"10s"`
+}
+
+type Metrics struct {
+	// QueryTimeout ограничивает подсчет сущностей в базе при сборе метрик
+	QueryTimeout time.Duration `envconfig:"METRICS_QUERY_TIMEOUT" default:"2s"`
+}
+
+type Tracing struct {
+	// Exporter - otlp (OTLP/HTTP), stdout (вывод в консоль для локального запуска) или none
+	Exporter    string  `envconfig:"TRACING_EXPORTER" default:"none"`
+	ServiceName string  `envconfig:"TRACING_SERVICE_NAME" default:"qna"`
+	SampleRatio float64 `envconfig:"TRACING_SAMPLE_RATIO" default:"1"`
+	// OTLPEndpoint - host:port коллектора; пустое значение - OTEL_EXPORTER_OTLP_ENDPOINT или localhost:4318
+	OTLPEndpoint string `envconfig:"TRACING_OTLP_ENDPOINT"`
+	OTLPInsecure bool   `envconfig:"TRACING_OTLP_INSECURE" default:"false"`
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	MinPasswordLength = 8
+	// bcrypt учитывает только первые 72 байта пароля
+	MaxPasswordLength = 72
+)
+
+// Principal - аутентифицированный пользователь, выполняющий запрос.
+type Principal struct {
+	UserId string
+}
+
+type principalCtxKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalCtxKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+type Credentials struct {
+	UserId       string
+	Name         string
+	PasswordHash string
+}
+
+type RefreshToken struct {
+	Id        string
+	UserId    string
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+const (
+	MinCommentLength = 2
+	MaxCommentLength = 600
+)
+
+// CommentParent - вид публикации, к которой относится комментарий.
+type CommentParent string
+
+const (
+	CommentParentQuestion CommentParent = "question"
+	CommentParentAnswer   CommentParent = "answer"
+)
+
+type Comment struct {
+	Id        int
+	Parent    CommentParent
+	ParentId  int
+	UserId    string
+	Text      string
+	CreatedAt time.Time
+}
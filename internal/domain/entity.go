@@ -1,8 +1,22 @@
 package domain
 
+import "time"
+
+const (
+	MaxQuestionTitleLength = 200
+)
+
 type Question struct {
-	Id   int
-	Text string
+	Id     int
+	UserId string
+	Title  string
+	Text   string
+	Score  int
+	Tags   []string
+	Status QuestionStatus
+	// AcceptedAnswerId - принятый автором ответ; nil, если ответ не принят
+	AcceptedAnswerId *int
+	CreatedAt        time.Time
 }
 
 type Answer struct {
@@ -10,9 +24,18 @@ type Answer struct {
 	QuestionId int
 	UserId     string
 	Text       string
+	Score      int
 }
 
 type User struct {
 	Id   string
 	Name string
+	Role Role
+}
+
+// EntityTotals - число неудаленных вопросов, ответов и пользователей.
+type EntityTotals struct {
+	Questions int64
+	Answers   int64
+	Users     int64
 }
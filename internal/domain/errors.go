@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Категории ошибок предметной области. Слои инфраструктуры оборачивают
+// в них свои ошибки, а REST-слой сопоставляет их с HTTP-статусами.
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrConflict          = errors.New("conflict")
+	ErrReferenceNotFound = errors.New("referenced entity not found")
+	ErrValidation        = errors.New("validation failed")
+	ErrForbidden         = errors.New("forbidden")
+	ErrUnauthorized      = errors.New("unauthorized")
+)
+
+// Error - ошибка предметной области с описанием, пригодным для клиента.
+type Error struct {
+	Kind error
+	Desc string
+}
+
+func NewError(kind error, desc string) error {
+	return &Error{Kind: kind, Desc: desc}
+}
+
+func (e *Error) Error() string {
+	return e.Desc + ": " + e.Kind.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// EventType - тип доменного события.
+type EventType string
+
+const (
+	EventQuestionCreated EventType = "question.created"
+	EventQuestionDeleted EventType = "question.deleted"
+	EventAnswerCreated   EventType = "answer.created"
+	EventAnswerDeleted   EventType = "answer.deleted"
+)
+
+func (t EventType) Valid() bool {
+	switch t {
+	case EventQuestionCreated, EventQuestionDeleted, EventAnswerCreated, EventAnswerDeleted:
+		return true
+	}
+	return false
+}
+
+// Event - доменное событие, публикуемое после успешной записи.
+// Id назначается при публикации и монотонно возрастает; AnswerId равен 0
+// для событий вопроса.
+type Event struct {
+	Id         int64
+	Type       EventType
+	QuestionId int
+	AnswerId   int
+	OccurredAt time.Time
+}
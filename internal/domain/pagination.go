@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// Cursor - позиция в списке, отсортированном по (created_at, id).
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	Id        string    `json:"i"`
+}
+
+// Encode возвращает непрозрачное для клиента представление курсора.
+func (c *Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cursor encoding")
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, errors.Wrap(err, "invalid cursor payload")
+	}
+	if cursor.Id == "" || cursor.CreatedAt.IsZero() {
+		return nil, errors.New("invalid cursor payload")
+	}
+
+	return &cursor, nil
+}
+
+type ListParams struct {
+	Limit         int
+	Order         SortOrder
+	After         *Cursor
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// Normalize подставляет значения по умолчанию и ограничивает размер страницы.
+func (p *ListParams) Normalize() {
+	if p.Limit <= 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		p.Limit = MaxPageLimit
+	}
+	if p.Order != SortAsc {
+		p.Order = SortDesc
+	}
+}
+
+type Page struct {
+	NextCursor *Cursor
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+const (
+	MaxUserNameLength = 100
+)
+
+// Revision - неизменяемый снимок текста вопроса или ответа после правки.
+// У ревизий ответов заголовок пуст.
+type Revision struct {
+	Id        int
+	EditorId  string
+	Title     string
+	Text      string
+	CreatedAt time.Time
+}
+
+// Патчи содержат только изменяемые поля; nil означает, что поле не меняется.
+type QuestionPatch struct {
+	Title *string
+	Text  *string
+	// Tags заменяет набор тегов целиком; правка тегов не создает ревизию
+	Tags *[]string
+}
+
+type AnswerPatch struct {
+	Text *string
+}
+
+type UserPatch struct {
+	Name *string
+	// Password - новый пароль; сервис проверяет его и передает репозиторию
+	// только PasswordHash
+	Password     *string
+	PasswordHash *string
+}
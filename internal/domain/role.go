@@ -0,0 +1,32 @@
+package domain
+
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// Permission - право на операцию. Соответствие ролей и прав хранится в таблице role_permissions.
+type Permission string
+
+const (
+	PermissionAnswerDeleteOwn  Permission = "answer.delete.own"
+	PermissionAnswerDeleteAny  Permission = "answer.delete.any"
+	PermissionQuestionDelete   Permission = "question.delete"
+	PermissionUserDelete       Permission = "user.delete"
+	PermissionQuestionEditOwn  Permission = "question.edit.own"
+	PermissionQuestionEditAny  Permission = "question.edit.any"
+	PermissionAnswerEditOwn    Permission = "answer.edit.own"
+	PermissionAnswerEditAny    Permission = "answer.edit.any"
+	PermissionUserEditOwn      Permission = "user.edit.own"
+	PermissionUserEditAny      Permission = "user.edit.any"
+	PermissionQuestionCloseOwn Permission = "question.close.own"
+	PermissionQuestionCloseAny Permission = "question.close.any"
+	PermissionQuestionLock     Permission = "question.lock"
+	PermissionCommentDeleteOwn Permission = "comment.delete.own"
+	PermissionCommentDeleteAny Permission = "comment.delete.any"
+	PermissionTrashManage      Permission = "trash.manage"
+	PermissionWebhookManage    Permission = "webhook.manage"
+)
@@ -0,0 +1,29 @@
+package domain
+
+const (
+	SearchKindQuestion = "question"
+	SearchKindAnswer   = "answer"
+)
+
+const (
+	MaxSearchQueryLength = 256
+)
+
+// SearchLanguages - конфигурации текстового поиска PostgreSQL, доступные для запроса.
+// Пустой язык означает поиск сразу по русской и английской конфигурациям.
+var SearchLanguages = []string{"russian", "english", "simple"}
+
+type SearchQuery struct {
+	Text     string
+	Language string
+	Limit    int
+	Offset   int
+}
+
+type SearchHit struct {
+	Kind       string
+	Id         int
+	QuestionId int
+	Rank       float64
+	Snippet    string
+}
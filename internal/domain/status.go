@@ -0,0 +1,44 @@
+package domain
+
+import "slices"
+
+// QuestionStatus - состояние жизненного цикла вопроса.
+type QuestionStatus string
+
+const (
+	QuestionStatusOpen     QuestionStatus = "open"
+	QuestionStatusAnswered QuestionStatus = "answered"
+	QuestionStatusClosed   QuestionStatus = "closed"
+	QuestionStatusLocked   QuestionStatus = "locked"
+)
+
+// questionTransitions - допустимые переходы между состояниями вопроса.
+// В answered вопрос переходит при принятии ответа, из answered в open -
+// при удалении принятого ответа.
+var questionTransitions = map[QuestionStatus][]QuestionStatus{
+	QuestionStatusOpen:     {QuestionStatusAnswered, QuestionStatusClosed, QuestionStatusLocked},
+	QuestionStatusAnswered: {QuestionStatusOpen, QuestionStatusClosed, QuestionStatusLocked},
+	QuestionStatusClosed:   {QuestionStatusOpen, QuestionStatusAnswered, QuestionStatusLocked},
+	QuestionStatusLocked:   {QuestionStatusOpen, QuestionStatusAnswered, QuestionStatusClosed},
+}
+
+func (s QuestionStatus) Valid() bool {
+	_, ok := questionTransitions[s]
+	return ok
+}
+
+func (s QuestionStatus) CanTransitionTo(next QuestionStatus) bool {
+	return slices.Contains(questionTransitions[s], next)
+}
+
+// AcceptsAnswers сообщает, можно ли добавлять ответы и принимать их.
+func (s QuestionStatus) AcceptsAnswers() bool {
+	return s == QuestionStatusOpen || s == QuestionStatusAnswered
+}
+
+// QuestionFilter - фильтры списка вопросов в дополнение к ListParams.
+type QuestionFilter struct {
+	Statuses []QuestionStatus
+	Tags     []string
+	TagMatch TagMatch
+}
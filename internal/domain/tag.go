@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	MaxQuestionTags = 5
+	MaxTagLength    = 35
+)
+
+// TagMatch - способ объединения нескольких тегов в фильтре списка вопросов.
+type TagMatch string
+
+const (
+	// TagMatchAll - у вопроса есть все перечисленные теги
+	TagMatchAll TagMatch = "all"
+	// TagMatchAny - у вопроса есть хотя бы один из тегов
+	TagMatchAny TagMatch = "any"
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9+#.-]*$`)
+
+type Tag struct {
+	Name          string
+	QuestionCount int
+}
+
+type TagQuery struct {
+	Limit  int
+	Offset int
+}
+
+// NormalizeTag приводит имя тега к каноническому виду: нижний регистр,
+// пробелы заменяются дефисом ("Go Modules" -> "go-modules").
+func NormalizeTag(name string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(name)), "-")
+	if normalized == "" {
+		return "", NewError(ErrValidation, "tag must not be empty")
+	}
+	if len(normalized) > MaxTagLength {
+		return "", NewError(ErrValidation, "tag \""+normalized+"\" is too long")
+	}
+	if !tagPattern.MatchString(normalized) {
+		return "", NewError(ErrValidation, "tag \""+normalized+"\" contains invalid characters")
+	}
+	return normalized, nil
+}
+
+// NormalizeTags нормализует теги вопроса, убирает повторы и проверяет их число.
+func NormalizeTags(names []string) ([]string, error) {
+	tags := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		tag, err := NormalizeTag(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+	if len(tags) > MaxQuestionTags {
+		return nil, NewError(ErrValidation, "a question can have at most 5 tags")
+	}
+	return tags, nil
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tags        []string
+		expected    []string
+		expectedErr error
+	}{
+		{name: "lowercase and dashes", tags: []string{" Go ", "Go Modules", "C#"}, expected: []string{"go", "go-modules", "c#"}},
+		{name: "duplicates", tags: []string{"postgres", "Postgres"}, expected: []string{"postgres"}},
+		{name: "empty", tags: []string{"  "}, expectedErr: ErrValidation},
+		{name: "invalid characters", tags: []string{"go/lang"}, expectedErr: ErrValidation},
+		{name: "too long", tags: []string{strings.Repeat("a", MaxTagLength+1)}, expectedErr: ErrValidation},
+		{name: "too many", tags: []string{"a", "b", "c", "d", "e", "f"}, expectedErr: ErrValidation},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, err := NormalizeTags(tt.tags)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, tags)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// TrashKind - вид мягко удаленной сущности в корзине.
+type TrashKind string
+
+const (
+	TrashKindQuestion TrashKind = "question"
+	TrashKindAnswer   TrashKind = "answer"
+	TrashKindUser     TrashKind = "user"
+)
+
+func (k TrashKind) Valid() bool {
+	switch k {
+	case TrashKindQuestion, TrashKindAnswer, TrashKindUser:
+		return true
+	}
+	return false
+}
+
+// TrashItem - запись корзины. Id - идентификатор сущности (для пользователя - UUID),
+// Title - заголовок вопроса, начало текста ответа или имя пользователя.
+type TrashItem struct {
+	Kind      TrashKind
+	Id        string
+	Title     string
+	DeletedAt time.Time
+}
+
+// TrashQuery - параметры просмотра корзины; пустой Kind выдает записи всех видов.
+type TrashQuery struct {
+	Kind   TrashKind
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+// DeletedUserId - служебный пользователь-заглушка, которому при анонимизации
+// передаются публикации удаляемого пользователя.
+const DeletedUserId = "00000000-0000-0000-0000-000000000000"
+
+// UserDeletionPolicy определяет судьбу публикаций удаляемого пользователя.
+type UserDeletionPolicy string
+
+const (
+	// UserDeletionCascade - вопросы и ответы уходят в корзину вместе с пользователем.
+	UserDeletionCascade UserDeletionPolicy = "cascade"
+	// UserDeletionAnonymize - публикации переходят к DeletedUserId,
+	// пользователь и его личные данные удаляются окончательно.
+	UserDeletionAnonymize UserDeletionPolicy = "anonymize"
+	// UserDeletionBlock - удаление запрещено, пока у пользователя есть публикации.
+	UserDeletionBlock UserDeletionPolicy = "block"
+)
+
+func (p UserDeletionPolicy) Valid() bool {
+	switch p {
+	case UserDeletionCascade, UserDeletionAnonymize, UserDeletionBlock:
+		return true
+	}
+	return false
+}
+
+// DeletedAnswer - ответ, ушедший в корзину вместе с автором.
+type DeletedAnswer struct {
+	Id         int
+	QuestionId int
+}
+
+// DeletedContent - вопросы и ответы, которые удаление пользователя по правилу
+// cascade поместило в корзину. Answers содержит только ответы на чужие вопросы:
+// ответы на вопросы пользователя удаляются вместе с вопросами.
+type DeletedContent struct {
+	QuestionIds []int
+	Answers     []DeletedAnswer
+}
+
+// Events возвращает события question.deleted и answer.deleted для удаленных публикаций.
+func (c *DeletedContent) Events(occurredAt time.Time) []Event {
+	events := make([]Event, 0, len(c.QuestionIds)+len(c.Answers))
+	for _, questionId := range c.QuestionIds {
+		events = append(events, Event{Type: EventQuestionDeleted, QuestionId: questionId, OccurredAt: occurredAt})
+	}
+	for _, answer := range c.Answers {
+		events = append(events, Event{
+			Type:       EventAnswerDeleted,
+			QuestionId: answer.QuestionId,
+			AnswerId:   answer.Id,
+			OccurredAt: occurredAt,
+		})
+	}
+	return events
+}
@@ -0,0 +1,20 @@
+package domain
+
+// VoteValue - голос пользователя за публикацию: за (+1) или против (-1).
+type VoteValue int
+
+const (
+	VoteUp   VoteValue = 1
+	VoteDown VoteValue = -1
+)
+
+func (v VoteValue) Valid() bool {
+	return v == VoteUp || v == VoteDown
+}
+
+// VoteResult - рейтинг публикации после голосования и текущий голос
+// пользователя (nil, если голос отозван).
+type VoteResult struct {
+	Score int
+	Vote  *VoteValue
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	MinWebhookSecretLength = 16
+	MaxWebhookURLLength    = 2048
+)
+
+// DeliveryStatus - состояние доставки события подписчику.
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+func (s DeliveryStatus) Valid() bool {
+	switch s {
+	case DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+// Webhook - подписка внешней системы на доменные события. Secret используется
+// для подписи запросов и наружу не отдается; пустой Events означает все события.
+type Webhook struct {
+	Id        int
+	UserId    string
+	URL       string
+	Secret    string
+	Events    []EventType
+	CreatedAt time.Time
+}
+
+// WebhookDelivery - доставка одного события одной подписке. NextAttemptAt
+// имеет смысл только для ожидающей доставки.
+type WebhookDelivery struct {
+	Id             int64
+	WebhookId      int
+	EventType      EventType
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int
+	LastStatusCode *int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookJob - доставка, захваченная на отправку, вместе с адресом и секретом
+// подписки. Attempt - номер текущей попытки, начиная с 1.
+type WebhookJob struct {
+	DeliveryId int64
+	EventType  EventType
+	Payload    json.RawMessage
+	Attempt    int
+	URL        string
+	Secret     string
+}
+
+// DeliveryResult - итог попытки доставки: Status pending означает повтор в NextAttemptAt.
+type DeliveryResult struct {
+	Status        DeliveryStatus
+	StatusCode    *int
+	Error         string
+	NextAttemptAt time.Time
+}
+
+// DeliveryQuery - параметры просмотра доставок подписки; пустой Status выдает все доставки.
+type DeliveryQuery struct {
+	WebhookId int
+	Status    DeliveryStatus
+	Limit     int
+	Offset    int
+}
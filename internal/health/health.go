@@ -0,0 +1,159 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Checker проверяет состояние компонента. Возвращает ошибку, если компонент неработоспособен.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+type Report struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+type CheckResult struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Registry хранит зарегистрированные компонентами проверки.
+//
+// Проверки запуска выполняются до первого успешного прохождения всех проверок,
+// после чего проба запуска считается пройденной навсегда. Проба готовности
+// не проходит, пока не пройдена проба запуска.
+type Registry struct {
+	mu        sync.RWMutex
+	liveness  map[string]Checker
+	readiness map[string]Checker
+	startup   map[string]Checker
+	started   atomic.Bool
+	timeout   time.Duration
+}
+
+func NewRegistry(timeout time.Duration) *Registry {
+	return &Registry{
+		liveness:  make(map[string]Checker),
+		readiness: make(map[string]Checker),
+		startup:   make(map[string]Checker),
+		timeout:   timeout,
+	}
+}
+
+func (t *Registry) RegisterLiveness(name string, checker Checker) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.liveness[name] = checker
+}
+
+func (t *Registry) RegisterReadiness(name string, checker Checker) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.readiness[name] = checker
+}
+
+func (t *Registry) RegisterStartup(name string, checker Checker) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.startup[name] = checker
+}
+
+func (t *Registry) Live(ctx context.Context) Report {
+	return t.run(ctx, t.checkers(t.liveness))
+}
+
+func (t *Registry) Ready(ctx context.Context) Report {
+	startup := t.Startup(ctx)
+	if startup.Status != StatusUp {
+		return startup
+	}
+	return t.run(ctx, t.checkers(t.readiness))
+}
+
+func (t *Registry) Startup(ctx context.Context) Report {
+	checkers := t.checkers(t.startup)
+	if t.started.Load() {
+		checkers = nil
+	}
+
+	report := t.run(ctx, checkers)
+	if report.Status == StatusUp {
+		t.started.Store(true)
+	}
+	return report
+}
+
+func (t *Registry) checkers(source map[string]Checker) map[string]Checker {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	checkers := make(map[string]Checker, len(source))
+	for name, checker := range source {
+		checkers[name] = checker
+	}
+	return checkers
+}
+
+// run выполняет проверки параллельно, каждую с ограничением по времени.
+func (t *Registry) run(ctx context.Context, checkers map[string]Checker) Report {
+	results := make([]CheckResult, 0, len(checkers))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, t.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := checker.Check(checkCtx)
+			result := CheckResult{
+				Name:      name,
+				Status:    StatusUp,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				result.Status = StatusDown
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+
+	report := Report{Status: StatusUp, Checks: results}
+	for _, result := range results {
+		if result.Status != StatusUp {
+			report.Status = StatusDown
+			break
+		}
+	}
+	return report
+}
@@ -0,0 +1,65 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryReady(t *testing.T) {
+	registry := NewRegistry(time.Second)
+	registry.RegisterReadiness("ok", CheckerFunc(func(ctx context.Context) error { return nil }))
+	registry.RegisterReadiness("broken", CheckerFunc(func(ctx context.Context) error { return errors.New("boom") }))
+
+	report := registry.Ready(context.Background())
+
+	assert.Equal(t, StatusDown, report.Status)
+	require.Len(t, report.Checks, 2)
+	assert.Equal(t, "broken", report.Checks[0].Name)
+	assert.Equal(t, StatusDown, report.Checks[0].Status)
+	assert.Equal(t, "boom", report.Checks[0].Error)
+	assert.Equal(t, "ok", report.Checks[1].Name)
+	assert.Equal(t, StatusUp, report.Checks[1].Status)
+}
+
+func TestRegistryCheckTimeout(t *testing.T) {
+	registry := NewRegistry(10 * time.Millisecond)
+	registry.RegisterLiveness("slow", CheckerFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	report := registry.Live(context.Background())
+
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks[0].Error)
+}
+
+func TestRegistryStartup(t *testing.T) {
+	registry := NewRegistry(time.Second)
+	migrated := false
+	registry.RegisterStartup("migrations", CheckerFunc(func(ctx context.Context) error {
+		if !migrated {
+			return errors.New("migrations are not applied")
+		}
+		return nil
+	}))
+
+	// пока проба запуска не пройдена, сервер не готов
+	assert.Equal(t, StatusDown, registry.Startup(context.Background()).Status)
+	assert.Equal(t, StatusDown, registry.Ready(context.Background()).Status)
+
+	migrated = true
+	assert.Equal(t, StatusUp, registry.Startup(context.Background()).Status)
+	assert.Equal(t, StatusUp, registry.Ready(context.Background()).Status)
+
+	// после успешного запуска проверки запуска больше не выполняются
+	migrated = false
+	report := registry.Startup(context.Background())
+	assert.Equal(t, StatusUp, report.Status)
+	assert.Empty(t, report.Checks)
+}
@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) ReadUserCredentials(ctx context.Context, userName *string) (*domain.Credentials, error) {
+	const op = "internal/infrastructure/db/auth.Repository.ReadUserCredentials"
+
+	var userDb dto.User
+
+	result := r.db.WithContext(ctx).Where("name = ?", *userName).First(&userDb)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return credentialsFromDto(&userDb), nil
+}
+
+func (r *Repository) ReadUserCredentialsById(ctx context.Context, userId *string) (*domain.Credentials, error) {
+	const op = "internal/infrastructure/db/auth.Repository.ReadUserCredentialsById"
+
+	var userDb dto.User
+
+	result := r.db.WithContext(ctx).Where("id = ?", *userId).First(&userDb)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return credentialsFromDto(&userDb), nil
+}
+
+func (r *Repository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	const op = "internal/infrastructure/db/auth.Repository.CreateRefreshToken"
+
+	tokenDb := refreshTokenToDto(token)
+
+	result := r.db.WithContext(ctx).Omit("User").Create(&tokenDb)
+	if result.Error != nil {
+		return errors.Wrap(translateError(result.Error), op)
+	}
+
+	token.Id = tokenDb.Id
+	return nil
+}
+
+func (r *Repository) ReadRefreshToken(ctx context.Context, tokenHash *string) (*domain.RefreshToken, error) {
+	const op = "internal/infrastructure/db/auth.Repository.ReadRefreshToken"
+
+	var tokenDb dto.RefreshToken
+
+	result := r.db.WithContext(ctx).Where("token_hash = ?", *tokenHash).First(&tokenDb)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return &domain.RefreshToken{
+		Id:        tokenDb.Id,
+		UserId:    tokenDb.UserId,
+		TokenHash: tokenDb.TokenHash,
+		ExpiresAt: tokenDb.ExpiresAt,
+		RevokedAt: tokenDb.RevokedAt,
+	}, nil
+}
+
+// RotateRefreshToken атомарно отзывает действующий токен и сохраняет новый.
+// Если токен уже отозван, возвращает domain.ErrConflict.
+func (r *Repository) RotateRefreshToken(ctx context.Context, oldTokenId *string, newToken *domain.RefreshToken) error {
+	const op = "internal/infrastructure/db/auth.Repository.RotateRefreshToken"
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tokenDb := refreshTokenToDto(newToken)
+		if err := tx.Omit("User").Create(&tokenDb).Error; err != nil {
+			return translateError(err)
+		}
+
+		result := tx.Model(&dto.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", *oldTokenId).
+			Updates(map[string]any{"revoked_at": time.Now(), "replaced_by": tokenDb.Id})
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrConflict
+		}
+
+		newToken.Id = tokenDb.Id
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeRefreshToken(ctx context.Context, tokenId *string) error {
+	const op = "internal/infrastructure/db/auth.Repository.RevokeRefreshToken"
+
+	result := r.db.WithContext(ctx).Model(&dto.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", *tokenId).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return errors.Wrap(translateError(result.Error), op)
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeUserRefreshTokens(ctx context.Context, userId *string) error {
+	const op = "internal/infrastructure/db/auth.Repository.RevokeUserRefreshTokens"
+
+	result := r.db.WithContext(ctx).Model(&dto.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", *userId).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return errors.Wrap(translateError(result.Error), op)
+	}
+
+	return nil
+}
+
+func credentialsFromDto(userDb *dto.User) *domain.Credentials {
+	credentials := domain.Credentials{
+		UserId: userDb.Id,
+		Name:   userDb.Name,
+	}
+	if userDb.PasswordHash != nil {
+		credentials.PasswordHash = *userDb.PasswordHash
+	}
+	return &credentials
+}
+
+func refreshTokenToDto(token *domain.RefreshToken) dto.RefreshToken {
+	return dto.RefreshToken{
+		UserId:    token.UserId,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+	}
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateComment(ctx context.Context, comment *domain.Comment) (commentId int, err error) {
+	const op = "internal/infrastructure/db/comment.Repository.CreateComment"
+
+	commentDb := dto.Comment{
+		UserId: comment.UserId,
+		Text:   comment.Text,
+	}
+	parentId := comment.ParentId
+	switch comment.Parent {
+	case domain.CommentParentQuestion:
+		commentDb.QuestionId = &parentId
+	case domain.CommentParentAnswer:
+		commentDb.AnswerId = &parentId
+	default:
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown comment parent"), op)
+	}
+
+	result := r.db.WithContext(ctx).Create(&commentDb)
+	if result.Error != nil {
+		return 0, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return commentDb.Id, nil
+}
+
+func (r *Repository) ReadComment(ctx context.Context, commentId int) (*domain.Comment, error) {
+	const op = "internal/infrastructure/db/comment.Repository.ReadComment"
+
+	var commentDb dto.Comment
+
+	result := r.db.WithContext(ctx).First(&commentDb, commentId)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	comment := commentFromDto(&commentDb)
+	return &comment, nil
+}
+
+// ReadComments возвращает комментарии публикации в порядке создания.
+func (r *Repository) ReadComments(ctx context.Context, parent domain.CommentParent, parentId int) (*[]domain.Comment, error) {
+	const op = "internal/infrastructure/db/comment.Repository.ReadComments"
+
+	query := r.db.WithContext(ctx)
+	switch parent {
+	case domain.CommentParentQuestion:
+		query = query.Where("question_id = ?", parentId)
+	case domain.CommentParentAnswer:
+		query = query.Where("answer_id = ?", parentId)
+	default:
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown comment parent"), op)
+	}
+
+	comments, err := findComments(query)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return comments, nil
+}
+
+// ReadThreadComments возвращает комментарии к вопросу и ко всем его ответам.
+func (r *Repository) ReadThreadComments(ctx context.Context, questionId int) (*[]domain.Comment, error) {
+	const op = "internal/infrastructure/db/comment.Repository.ReadThreadComments"
+
+	db := r.db.WithContext(ctx)
+	answerIds := db.Model(&dto.Answer{}).Select("id").Where("question_id = ?", questionId)
+	query := db.Where("question_id = ? OR answer_id IN (?)", questionId, answerIds)
+
+	comments, err := findComments(query)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return comments, nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, commentId int) error {
+	const op = "internal/infrastructure/db/comment.Repository.DeleteComment"
+
+	result := r.db.WithContext(ctx).Delete(&dto.Comment{}, commentId)
+	if result.Error != nil {
+		return errors.Wrap(translateDeleteError(result.Error), op)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrap(ErrNotFound, op)
+	}
+
+	return nil
+}
+
+func findComments(query *gorm.DB) (*[]domain.Comment, error) {
+	var commentsDb []dto.Comment
+	result := query.Order("created_at ASC, id ASC").Find(&commentsDb)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+
+	comments := make([]domain.Comment, 0, len(commentsDb))
+	for _, c := range commentsDb {
+		comments = append(comments, commentFromDto(&c))
+	}
+	return &comments, nil
+}
+
+func commentFromDto(commentDb *dto.Comment) domain.Comment {
+	comment := domain.Comment{
+		Id:        commentDb.Id,
+		UserId:    commentDb.UserId,
+		Text:      commentDb.Text,
+		CreatedAt: commentDb.CreatedAt,
+	}
+	if commentDb.QuestionId != nil {
+		comment.Parent, comment.ParentId = domain.CommentParentQuestion, *commentDb.QuestionId
+	}
+	if commentDb.AnswerId != nil {
+		comment.Parent, comment.ParentId = domain.CommentParentAnswer, *commentDb.AnswerId
+	}
+	return comment
+}
@@ -2,12 +2,22 @@ package dto
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Question struct {
-	Id        int `gorm:"primaryKey;autoIncrement"`
-	Text      string
-	CreatedAt time.Time
+	Id               int     `gorm:"primaryKey;autoIncrement"`
+	UserId           *string `gorm:"type:uuid"`
+	Title            string  `gorm:"type:varchar(200);not null"`
+	Text             string
+	Score            int    `gorm:"not null;default:0"`
+	Status           string `gorm:"type:varchar(20);not null;default:open"`
+	AcceptedAnswerId *int
+	CreatedAt        time.Time
+	DeletedAt        gorm.DeletedAt
+
+	User *User `gorm:"foreignKey:UserId;references:Id;constraint:OnDelete:CASCADE"`
 }
 
 type Answer struct {
@@ -15,14 +25,107 @@ type Answer struct {
 	QuestionId int
 	UserId     string
 	Text       string
+	Score      int `gorm:"not null;default:0"`
 	CreatedAt  time.Time
+	DeletedAt  gorm.DeletedAt
 
 	Question Question `gorm:"foreignKey:QuestionId;references:Id;constraint:OnDelete:CASCADE"`
 	User     User     `gorm:"foreignKey:UserId;references:Id;constraint:OnDelete:CASCADE"`
 }
 
 type User struct {
-	Id        string `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Name      string `gorm:"type:varchar(100);not null"`
+	Id           string `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name         string `gorm:"type:varchar(100);not null;uniqueIndex:idx_users_name"`
+	PasswordHash *string
+	Role         string `gorm:"type:varchar(20);not null;default:user"`
+	CreatedAt    time.Time
+	DeletedAt    gorm.DeletedAt
+}
+
+type RefreshToken struct {
+	Id         string `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId     string `gorm:"type:uuid;not null"`
+	TokenHash  string `gorm:"type:varchar(64);not null;uniqueIndex:uq_refresh_tokens_token_hash"`
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *string `gorm:"type:uuid"`
+	CreatedAt  time.Time
+
+	User User `gorm:"foreignKey:UserId;references:Id;constraint:OnDelete:CASCADE"`
+}
+
+type QuestionRevision struct {
+	Id         int     `gorm:"primaryKey;autoIncrement"`
+	QuestionId int     `gorm:"not null"`
+	EditorId   *string `gorm:"type:uuid"`
+	Title      string  `gorm:"type:varchar(200);not null"`
+	Text       string  `gorm:"not null"`
+	CreatedAt  time.Time
+}
+
+type AnswerRevision struct {
+	Id        int     `gorm:"primaryKey;autoIncrement"`
+	AnswerId  int     `gorm:"not null"`
+	EditorId  *string `gorm:"type:uuid"`
+	Text      string  `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+type QuestionVote struct {
+	QuestionId int    `gorm:"primaryKey"`
+	UserId     string `gorm:"primaryKey;type:uuid"`
+	Value      int    `gorm:"type:smallint;not null"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type AnswerVote struct {
+	AnswerId  int    `gorm:"primaryKey"`
+	UserId    string `gorm:"primaryKey;type:uuid"`
+	Value     int    `gorm:"type:smallint;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Tag struct {
+	Id        int    `gorm:"primaryKey;autoIncrement"`
+	Name      string `gorm:"type:varchar(35);not null;uniqueIndex:uq_tags_name"`
+	CreatedAt time.Time
+}
+
+type QuestionTag struct {
+	QuestionId int `gorm:"primaryKey"`
+	TagId      int `gorm:"primaryKey"`
+}
+
+type Comment struct {
+	Id         int `gorm:"primaryKey;autoIncrement"`
+	QuestionId *int
+	AnswerId   *int
+	UserId     string `gorm:"type:uuid;not null"`
+	Text       string `gorm:"type:varchar(600);not null"`
+	CreatedAt  time.Time
+}
+
+type Webhook struct {
+	Id        int      `gorm:"primaryKey;autoIncrement"`
+	UserId    string   `gorm:"type:uuid;not null"`
+	URL       string   `gorm:"column:url;type:varchar(2048);not null"`
+	Secret    string   `gorm:"not null"`
+	Events    []string `gorm:"type:jsonb;serializer:json;not null"`
 	CreatedAt time.Time
 }
+
+type WebhookDelivery struct {
+	Id             int64  `gorm:"primaryKey;autoIncrement"`
+	WebhookId      int    `gorm:"not null"`
+	EventType      string `gorm:"type:varchar(50);not null"`
+	Payload        string `gorm:"type:jsonb;not null"`
+	Status         string `gorm:"type:varchar(20);not null"`
+	Attempts       int    `gorm:"not null"`
+	LastStatusCode *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
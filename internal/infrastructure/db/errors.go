@@ -0,0 +1,60 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Коды ошибок PostgreSQL (https://www.postgresql.org/docs/current/errcodes-appendix.html)
+const (
+	pgCodeNotNullViolation     = "23502"
+	pgCodeForeignKeyViolation  = "23503"
+	pgCodeUniqueViolation      = "23505"
+	pgCodeCheckViolation       = "23514"
+	pgCodeStringDataRightTrunc = "22001"
+	pgCodeInvalidTextRepr      = "22P02"
+)
+
+// translateError переводит ошибки GORM и PostgreSQL в ошибки предметной области,
+// сохраняя исходную ошибку в цепочке.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case pgCodeForeignKeyViolation:
+		// вставка или изменение записи со ссылкой на несуществующую запись
+		return fmt.Errorf("%w: %w", domain.ErrReferenceNotFound, err)
+	case pgCodeUniqueViolation:
+		return fmt.Errorf("%w: %w", domain.ErrConflict, err)
+	case pgCodeNotNullViolation, pgCodeCheckViolation, pgCodeStringDataRightTrunc, pgCodeInvalidTextRepr:
+		return fmt.Errorf("%w: %w", domain.ErrValidation, err)
+	}
+
+	return err
+}
+
+// translateDeleteError переводит ошибки удаления записей. Нарушение внешнего
+// ключа при удалении означает, что на запись еще ссылаются другие записи;
+// остальные ошибки переводятся как в translateError.
+func translateDeleteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgCodeForeignKeyViolation {
+		return fmt.Errorf("%w: %w", domain.ErrConflict, err)
+	}
+	return translateError(err)
+}
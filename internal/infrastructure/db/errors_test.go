@@ -0,0 +1,67 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTranslateError(t *testing.T) {
+	foreignKey := &pgconn.PgError{Code: pgCodeForeignKeyViolation, ConstraintName: "fk_answers_question"}
+
+	testCases := []struct {
+		name      string
+		translate func(error) error
+		err       error
+		expected  error
+	}{
+		{
+			name:      "Record not found",
+			translate: translateError,
+			err:       gorm.ErrRecordNotFound,
+			expected:  domain.ErrNotFound,
+		},
+		{
+			name:      "Unique violation",
+			translate: translateError,
+			err:       &pgconn.PgError{Code: pgCodeUniqueViolation},
+			expected:  domain.ErrConflict,
+		},
+		{
+			name:      "Check violation",
+			translate: translateError,
+			err:       &pgconn.PgError{Code: pgCodeCheckViolation},
+			expected:  domain.ErrValidation,
+		},
+		{
+			name:      "Foreign key violation on insert",
+			translate: translateError,
+			err:       foreignKey,
+			expected:  domain.ErrReferenceNotFound,
+		},
+		{
+			name:      "Foreign key violation on delete",
+			translate: translateDeleteError,
+			err:       foreignKey,
+			expected:  domain.ErrConflict,
+		},
+		{
+			name:      "Other error on delete",
+			translate: translateDeleteError,
+			err:       &pgconn.PgError{Code: pgCodeUniqueViolation},
+			expected:  domain.ErrConflict,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.translate(errors.Wrap(tt.err, "op"))
+			assert.ErrorIs(t, err, tt.expected)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	eventsChannel = "qna_events"
+
+	minReconnectDelay = 100 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+
+	// publishTimeout ограничивает отправку события, отвязанную от контекста запроса
+	publishTimeout = 5 * time.Second
+)
+
+// publishEventSQL нумерует событие общей последовательностью и рассылает его
+// всем слушателям канала одним запросом. Номер выдается до фиксации, поэтому
+// параллельно опубликованные события могут прийти не по возрастанию Id;
+// уведомления доставляются в порядке фиксации, одинаковом для всех слушателей.
+const publishEventSQL = `
+SELECT pg_notify(@channel, json_build_object(
+	'id', nextval('event_ids'),
+	'type', @type::text,
+	'question_id', @question_id::int,
+	'answer_id', @answer_id::int,
+	'occurred_at', @occurred_at::timestamptz
+)::text)`
+
+type eventPayload struct {
+	Id         int64     `json:"id"`
+	Type       string    `json:"type"`
+	QuestionId int       `json:"question_id"`
+	AnswerId   int       `json:"answer_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// EventBus - шина событий на Postgres LISTEN/NOTIFY: события, опубликованные
+// любым экземпляром сервиса, получают слушатели всех экземпляров.
+// События, опубликованные, пока слушатель переподключается, не доставляются.
+// Id событий общие для всех экземпляров, но могут приходить не по возрастанию:
+// usecase.EventBroker возобновляет поток по порядку получения, а не по Id.
+type EventBus struct {
+	repo *Repository
+	log  *slog.Logger
+}
+
+func NewEventBus(repo *Repository, log *slog.Logger) *EventBus {
+	return &EventBus{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// Publish рассылает событие о выполненной записи. Отмена ctx (например, обрыв
+// соединения клиента сразу после записи) не прерывает отправку.
+func (t *EventBus) Publish(ctx context.Context, event domain.Event) {
+	const op = "internal/infrastructure/db/events.EventBus.Publish"
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+	defer cancel()
+
+	result := t.repo.db.WithContext(ctx).Exec(publishEventSQL, map[string]any{
+		"channel":     eventsChannel,
+		"type":        string(event.Type),
+		"question_id": event.QuestionId,
+		"answer_id":   event.AnswerId,
+		"occurred_at": event.OccurredAt,
+	})
+	if result.Error != nil {
+		t.log.Error("failed to publish event",
+			slog.String("operation", op),
+			slog.String("type", string(event.Type)),
+			slog.String("error", result.Error.Error()),
+		)
+	}
+}
+
+// Listen слушает канал на отдельном соединении и при обрыве переподключается
+// с экспоненциальной задержкой, пока не отменен ctx.
+func (t *EventBus) Listen(ctx context.Context, handler func(domain.Event)) error {
+	const op = "internal/infrastructure/db/events.EventBus.Listen"
+
+	delay := minReconnectDelay
+	for {
+		connected, err := t.listen(ctx, handler)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if connected {
+			delay = minReconnectDelay
+		}
+
+		t.log.Warn("event listener disconnected",
+			slog.String("operation", op),
+			slog.String("error", err.Error()),
+			slog.Duration("retry_in", delay),
+		)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// listen держит одно соединение LISTEN до ошибки; connected сообщает,
+// что подписка на канал была установлена.
+func (t *EventBus) listen(ctx context.Context, handler func(domain.Event)) (connected bool, err error) {
+	const op = "internal/infrastructure/db/events.EventBus.listen"
+
+	conn, err := pgx.Connect(ctx, t.repo.connString)
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return false, errors.Wrap(err, op)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, errors.Wrap(err, op)
+		}
+
+		var payload eventPayload
+		if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			t.log.Error("failed to decode event",
+				slog.String("operation", op),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		handler(domain.Event{
+			Id:         payload.Id,
+			Type:       domain.EventType(payload.Type),
+			QuestionId: payload.QuestionId,
+			AnswerId:   payload.AnswerId,
+			OccurredAt: payload.OccurredAt,
+		})
+	}
+}
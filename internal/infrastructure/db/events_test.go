@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHandler запоминает записи журнала для проверки в тестах.
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, record slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, record.Clone())
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *recordingHandler) WithGroup(string) slog.Handler { return h }
+
+// retryDelays возвращает задержки переподключения из записей журнала.
+func (h *recordingHandler) retryDelays() []time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var delays []time.Duration
+	for _, record := range h.records {
+		record.Attrs(func(attr slog.Attr) bool {
+			if attr.Key == "retry_in" {
+				delays = append(delays, attr.Value.Duration())
+			}
+			return true
+		})
+	}
+	return delays
+}
+
+func TestEventBusListenReconnects(t *testing.T) {
+	// порт 1 закрыт: каждое подключение слушателя сразу завершается ошибкой
+	repo := &Repository{connString: "postgres://qna@127.0.0.1:1/qna?connect_timeout=1"}
+	handler := &recordingHandler{}
+	bus := NewEventBus(repo, slog.New(handler))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- bus.Listen(ctx, func(domain.Event) {})
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(handler.retryDelays()) >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Listen did not stop after ctx cancellation")
+	}
+
+	delays := handler.retryDelays()
+	assert.Equal(t, []time.Duration{minReconnectDelay, 2 * minReconnectDelay, 4 * minReconnectDelay}, delays[:3])
+}
+
+func TestEventBus(t *testing.T) {
+	repo := newTestRepository(t)
+	bus := NewEventBus(repo, slog.New(slog.DiscardHandler))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan domain.Event, 16)
+	go bus.Listen(ctx, func(event domain.Event) {
+		select {
+		case received <- event:
+		case <-ctx.Done():
+		}
+	})
+
+	// publishUntilReceived публикует событие, пока слушатель его не получит:
+	// подписка на канал устанавливается асинхронно
+	publishUntilReceived := func(t *testing.T, publishCtx context.Context, questionId int) domain.Event {
+		t.Helper()
+		deadline := time.After(10 * time.Second)
+		for {
+			bus.Publish(publishCtx, domain.Event{Type: domain.EventQuestionCreated, QuestionId: questionId, OccurredAt: time.Now()})
+			select {
+			case event := <-received:
+				if event.QuestionId == questionId {
+					return event
+				}
+			case <-time.After(100 * time.Millisecond):
+			case <-deadline:
+				t.Fatalf("event for question %d was not received", questionId)
+			}
+		}
+	}
+
+	first := publishUntilReceived(t, ctx, 1)
+	assert.Equal(t, domain.EventQuestionCreated, first.Type)
+	assert.Positive(t, first.Id)
+
+	t.Run("canceled request context", func(t *testing.T) {
+		canceled, cancelRequest := context.WithCancel(context.Background())
+		cancelRequest()
+
+		event := publishUntilReceived(t, canceled, 2)
+		assert.Greater(t, event.Id, first.Id)
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		result := repo.db.Exec(`SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE query = ?`, "LISTEN "+eventsChannel)
+		require.NoError(t, result.Error)
+
+		publishUntilReceived(t, ctx, 3)
+	})
+}
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+const countEntitiesSQL = `
+SELECT
+	(SELECT count(*) FROM questions WHERE deleted_at IS NULL) AS questions,
+	(SELECT count(*) FROM answers WHERE deleted_at IS NULL) AS answers,
+	(SELECT count(*) FROM users WHERE deleted_at IS NULL AND id <> @deleted_user_id) AS users`
+
+// CountEntities возвращает число неудаленных вопросов, ответов и пользователей
+// без служебного пользователя deleted user.
+func (r *Repository) CountEntities(ctx context.Context) (*domain.EntityTotals, error) {
+	const op = "internal/infrastructure/db/metrics.Repository.CountEntities"
+
+	var totals domain.EntityTotals
+	result := r.db.WithContext(ctx).Raw(countEntitiesSQL, map[string]any{
+		"deleted_user_id": domain.DeletedUserId,
+	}).Scan(&totals)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return &totals, nil
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+
+	"github.com/Vy4cheSlave/qna/migrations"
+	"github.com/pkg/errors"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// Migrator применяет встроенные в бинарный файл goose-миграции. Изменяющие
+// схему команды выполняются под advisory-блокировкой Postgres, поэтому
+// экземпляры, запущенные одновременно, применяют миграции по очереди.
+type Migrator struct {
+	provider *goose.Provider
+	// unlocked выполняет шаги составных команд, для которых блокировку
+	// удерживает сам Migrator (см. Redo)
+	unlocked *goose.Provider
+	db       *sql.DB
+	locker   lock.SessionLocker
+}
+
+func NewMigrator(repo *Repository) (*Migrator, error) {
+	const op = "internal/infrastructure/db/migrate.NewMigrator"
+
+	sqlDB, err := repo.SQLDB()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	fsys, err := fs.Sub(migrations.Postgres, migrations.PostgresDir)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	// блокировка удерживается на время всей команды; ожидание чужой
+	// блокировки ограничено настройками по умолчанию (около 5 минут)
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, sqlDB, fsys, goose.WithSessionLocker(locker))
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	unlocked, err := goose.NewProvider(goose.DialectPostgres, sqlDB, fsys)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return &Migrator{
+		provider: provider,
+		unlocked: unlocked,
+		db:       sqlDB,
+		locker:   locker,
+	}, nil
+}
+
+// Up применяет все непримененные миграции.
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	const op = "internal/infrastructure/db/migrate.Migrator.Up"
+
+	results, err := m.provider.Up(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return results, nil
+}
+
+// Down откатывает последнюю примененную миграцию.
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	const op = "internal/infrastructure/db/migrate.Migrator.Down"
+
+	result, err := m.provider.Down(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return result, nil
+}
+
+// Redo откатывает и заново применяет последнюю примененную миграцию. Блокировка
+// удерживается на время обоих шагов, чтобы другой экземпляр не применил
+// миграции между откатом и повторным применением.
+func (m *Migrator) Redo(ctx context.Context) (results []*goose.MigrationResult, err error) {
+	const op = "internal/infrastructure/db/migrate.Migrator.Redo"
+
+	// шаги выполняются на соединениях пула, отличных от соединения с блокировкой
+	if m.db.Stats().MaxOpenConnections == 1 {
+		return nil, errors.Wrap(errors.New("redo requires a connection pool of at least 2 connections"), op)
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	defer conn.Close()
+
+	if err := m.locker.SessionLock(ctx, conn); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	defer func() {
+		if unlockErr := m.locker.SessionUnlock(context.WithoutCancel(ctx), conn); unlockErr != nil && err == nil {
+			err = errors.Wrap(unlockErr, op)
+		}
+	}()
+
+	down, err := m.unlocked.Down(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	up, err := m.unlocked.ApplyVersion(ctx, down.Source.Version, true)
+	if err != nil {
+		return []*goose.MigrationResult{down}, errors.Wrap(err, op)
+	}
+
+	return []*goose.MigrationResult{down, up}, nil
+}
+
+// Status возвращает состояние каждой встроенной миграции.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	const op = "internal/infrastructure/db/migrate.Migrator.Status"
+
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return statuses, nil
+}
+
+// Version возвращает номер последней примененной миграции.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	const op = "internal/infrastructure/db/migrate.Migrator.Version"
+
+	version, err := m.provider.GetDBVersion(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return version, nil
+}
+
+// Latest возвращает номер последней встроенной миграции - версию схемы,
+// которую ожидает этот бинарный файл.
+func (m *Migrator) Latest() int64 {
+	var latest int64
+	for _, source := range m.provider.ListSources() {
+		latest = max(latest, source.Version)
+	}
+	return latest
+}
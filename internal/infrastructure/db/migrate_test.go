@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigratorRedoHoldsLock проверяет, что Redo берет блокировку миграций до
+// отката: пока ее держит другой экземпляр, схема не меняется.
+func TestMigratorRedoHoldsLock(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+
+	migrator, err := NewMigrator(repo)
+	require.NoError(t, err)
+	version, err := migrator.Version(ctx)
+	require.NoError(t, err)
+
+	sqlDB, err := repo.SQLDB()
+	require.NoError(t, err)
+	conn, err := sqlDB.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, migrator.locker.SessionLock(ctx, conn))
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err = migrator.Redo(waitCtx)
+	assert.Error(t, err)
+
+	current, err := migrator.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, version, current)
+
+	require.NoError(t, migrator.locker.SessionUnlock(ctx, conn))
+
+	results, err := migrator.Redo(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, version, results[0].Source.Version)
+	assert.Equal(t, version, results[1].Source.Version)
+
+	current, err = migrator.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, version, current)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"gorm.io/gorm"
+)
+
+// paginate добавляет к запросу фильтры по дате создания и keyset-пагинацию
+// по (created_at, id). Запрашивается на одну запись больше лимита, чтобы
+// определить наличие следующей страницы.
+func paginate(query *gorm.DB, params *domain.ListParams, cursorId any) *gorm.DB {
+	if params.CreatedAfter != nil {
+		query = query.Where("created_at > ?", *params.CreatedAfter)
+	}
+	if params.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *params.CreatedBefore)
+	}
+
+	if params.After != nil {
+		if params.Order == domain.SortAsc {
+			query = query.Where("(created_at, id) > (?, ?)", params.After.CreatedAt, cursorId)
+		} else {
+			query = query.Where("(created_at, id) < (?, ?)", params.After.CreatedAt, cursorId)
+		}
+	}
+
+	if params.Order == domain.SortAsc {
+		query = query.Order("created_at ASC, id ASC")
+	} else {
+		query = query.Order("created_at DESC, id DESC")
+	}
+
+	return query.Limit(params.Limit + 1)
+}
+
+// nextPage отрезает лишнюю запись и строит курсор на следующую страницу.
+func nextPage[T any](rows []T, limit int, key func(T) (time.Time, string)) ([]T, *domain.Page) {
+	if len(rows) <= limit {
+		return rows, &domain.Page{}
+	}
+
+	rows = rows[:limit]
+	createdAt, id := key(rows[len(rows)-1])
+	return rows, &domain.Page{NextCursor: &domain.Cursor{CreatedAt: createdAt, Id: id}}
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (r *Repository) HasPermission(ctx context.Context, userId *string, permission domain.Permission) (bool, error) {
+	const op = "internal/infrastructure/db/policy.Repository.HasPermission"
+
+	var allowed bool
+	result := r.db.WithContext(ctx).Raw(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM users u
+			JOIN role_permissions rp ON rp.role = u.role
+			WHERE u.id = ? AND u.deleted_at IS NULL AND rp.permission = ?
+		)`, *userId, string(permission)).Scan(&allowed)
+	if result.Error != nil {
+		return false, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return allowed, nil
+}
@@ -2,28 +2,28 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"github.com/Vy4cheSlave/qna/internal/config"
+	"github.com/pkg/errors"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/plugin/opentelemetry/tracing"
 )
 
 func NewRepository(ctx context.Context, cfg config.PostgreSQL) (*Repository, error) {
-	connString := fmt.Sprintf(
-		`user=%s password=%s host=%s port=%d dbname=%s sslmode=%s`,
-		cfg.User,
-		cfg.Password,
-		cfg.Host,
-		cfg.Port,
-		cfg.Name,
-		cfg.SSLMode,
-	)
+	connString := connectionString(cfg)
 
 	gormDB, err := gorm.Open(postgres.Open(connString), &gorm.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to open gorm connection: %w", err)
 	}
 
+	// span на каждый запрос; значения параметров в span не попадают
+	if err := gormDB.Use(tracing.NewPlugin(tracing.WithoutMetrics(), tracing.WithoutQueryVariables())); err != nil {
+		return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+	}
+
 	sqlDB, err := gormDB.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
@@ -37,10 +37,81 @@ func NewRepository(ctx context.Context, cfg config.PostgreSQL) (*Repository, err
 		return nil, fmt.Errorf("database ping failed: %w", err)
 	}
 
-	return &Repository{db: gormDB}, nil
+	return &Repository{db: gormDB, connString: connString}, nil
+
+}
 
+func connectionString(cfg config.PostgreSQL) string {
+	return fmt.Sprintf(
+		`user=%s password=%s host=%s port=%d dbname=%s sslmode=%s`,
+		cfg.User,
+		cfg.Password,
+		cfg.Host,
+		cfg.Port,
+		cfg.Name,
+		cfg.SSLMode,
+	)
 }
 
 type Repository struct {
 	db *gorm.DB
+	// connString нужен для отдельных соединений вне пула (LISTEN)
+	connString string
+}
+
+func (r *Repository) Close() error {
+	const op = "internal/infrastructure/db/postgres.Repository.Close"
+
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := sqlDB.Close(); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// SQLDB возвращает пул соединений, например для сбора его статистики.
+func (r *Repository) SQLDB() (*sql.DB, error) {
+	const op = "internal/infrastructure/db/postgres.Repository.SQLDB"
+
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	return sqlDB, nil
+}
+
+func (r *Repository) Ping(ctx context.Context) error {
+	const op = "internal/infrastructure/db/postgres.Repository.Ping"
+
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// MigrationVersion возвращает номер последней примененной goose-миграции.
+func (r *Repository) MigrationVersion(ctx context.Context) (int64, error) {
+	const op = "internal/infrastructure/db/postgres.Repository.MigrationVersion"
+
+	var version int64
+	result := r.db.WithContext(ctx).
+		Raw(`SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied`).
+		Scan(&version)
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, op)
+	}
+
+	return version, nil
 }
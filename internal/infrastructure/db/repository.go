@@ -2,28 +2,33 @@ package db
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/Vy4cheSlave/qna/internal/domain"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
 
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound = domain.ErrNotFound
 )
 
-func (r *Repository) CreateUser(ctx context.Context, userName *string) (userId *string, err error) {
+func (r *Repository) CreateUser(ctx context.Context, userName *string, passwordHash *string) (userId *string, err error) {
 	const op = "internal/infrastructure/db/repository.Repository.CreateUser"
 
 	newUser := dto.User{
-		Name: *userName,
+		Name:         *userName,
+		PasswordHash: passwordHash,
 	}
 
 	result := r.db.WithContext(ctx).Create(&newUser)
 
 	if result.Error != nil {
-		return nil, errors.Wrap(result.Error, op)
+		return nil, errors.Wrap(translateError(result.Error), op)
 	}
 
 	if result.RowsAffected == 0 {
@@ -33,81 +38,203 @@ func (r *Repository) CreateUser(ctx context.Context, userName *string) (userId *
 	return &newUser.Id, nil
 }
 
-func (r *Repository) ReadUsers(ctx context.Context) (*[]domain.User, error) {
+func (r *Repository) ReadUsers(ctx context.Context, params *domain.ListParams) (*[]domain.User, *domain.Page, error) {
 	const op = "internal/infrastructure/db/repository.Repository.ReadUsers"
 
 	var usersDb []dto.User
 
-	result := r.db.WithContext(ctx).Find(&usersDb)
+	var cursorId any
+	if params.After != nil {
+		cursorId = params.After.Id
+	}
+
+	// служебный пользователь-заглушка в список не попадает
+	query := r.db.WithContext(ctx).Where("id <> ?", domain.DeletedUserId)
+	result := paginate(query, params, cursorId).Find(&usersDb)
 
 	if result.Error != nil {
-		return nil, errors.Wrap(result.Error, op)
+		return nil, nil, errors.Wrap(translateError(result.Error), op)
 	}
 
+	usersDb, page := nextPage(usersDb, params.Limit, func(u dto.User) (time.Time, string) {
+		return u.CreatedAt, u.Id
+	})
+
 	users := make([]domain.User, 0, len(usersDb))
 	for _, user := range usersDb {
 		users = append(users, domain.User{
 			Id:   user.Id,
 			Name: user.Name,
+			Role: domain.Role(user.Role),
 		})
 	}
 
-	return &users, nil
+	return &users, page, nil
 }
 
-func (r *Repository) DeleteUser(ctx context.Context, userId *string) error {
-	const op = "internal/infrastructure/db/repository.Repository.DeleteUser"
+func (r *Repository) UpdateUser(ctx context.Context, userId *string, patch *domain.UserPatch) (*domain.User, error) {
+	const op = "internal/infrastructure/db/repository.Repository.UpdateUser"
+
+	var userDb dto.User
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", *userId).First(&userDb)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		if patch.Name != nil && *patch.Name != userDb.Name {
+			result = tx.Model(&dto.User{}).Where("id = ?", userDb.Id).Update("name", *patch.Name)
+			if result.Error != nil {
+				return translateError(result.Error)
+			}
+			userDb.Name = *patch.Name
+		}
+
+		// смена пароля отзывает выданные refresh-токены пользователя
+		if patch.PasswordHash != nil {
+			result = tx.Model(&dto.User{}).Where("id = ?", userDb.Id).Update("password_hash", *patch.PasswordHash)
+			if result.Error != nil {
+				return translateError(result.Error)
+			}
+			result = tx.Model(&dto.RefreshToken{}).
+				Where("user_id = ? AND revoked_at IS NULL", userDb.Id).
+				Update("revoked_at", time.Now())
+			if result.Error != nil {
+				return translateError(result.Error)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
 
-	result := r.db.WithContext(ctx).Delete(&domain.User{}, "id = ?", *userId)
+	return &domain.User{
+		Id:   userDb.Id,
+		Name: userDb.Name,
+		Role: domain.Role(userDb.Role),
+	}, nil
+}
 
-	if result.Error != nil {
-		return errors.Wrap(result.Error, op)
-	}
+// DeleteUser удаляет пользователя по правилу policy в одной транзакции:
+// cascade мягко удаляет пользователя вместе с публикациями, anonymize передает
+// публикации заглушке domain.DeletedUserId и удаляет пользователя окончательно,
+// block отказывает, пока у пользователя есть публикации.
+func (r *Repository) DeleteUser(ctx context.Context, userId *string, policy domain.UserDeletionPolicy) (*domain.DeletedContent, error) {
+	const op = "internal/infrastructure/db/repository.Repository.DeleteUser"
 
-	if result.RowsAffected == 0 {
-		return errors.Wrap(ErrNotFound, op)
+	deleted := &domain.DeletedContent{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var userDb dto.User
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", *userId).First(&userDb)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		switch policy {
+		case domain.UserDeletionAnonymize:
+			return anonymizeUser(tx, *userId)
+		case domain.UserDeletionBlock:
+			if err := requireNoContent(tx, *userId); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		deleted, err = softDeleteUser(tx, *userId, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, op)
 	}
 
-	return nil
+	return deleted, nil
 }
 
-func (r *Repository) ReadQuestions(ctx context.Context) (*[]domain.Question, error) {
+func (r *Repository) ReadQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error) {
 	const op = "internal/infrastructure/db/repository.Repository.ReadQuestion"
 
 	var questionsDb []dto.Question
 
-	result := r.db.WithContext(ctx).Find(&questionsDb)
+	var cursorId any
+	if params.After != nil {
+		id, err := strconv.Atoi(params.After.Id)
+		if err != nil {
+			return nil, nil, errors.Wrap(domain.NewError(domain.ErrValidation, "invalid cursor"), op)
+		}
+		cursorId = id
+	}
+
+	query := r.db.WithContext(ctx)
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	query = filterByTags(query, filter)
+
+	result := paginate(query, params, cursorId).Find(&questionsDb)
 
 	if result.Error != nil {
-		return nil, errors.Wrap(result.Error, op)
+		return nil, nil, errors.Wrap(translateError(result.Error), op)
 	}
 
+	questionsDb, page := nextPage(questionsDb, params.Limit, func(q dto.Question) (time.Time, string) {
+		return q.CreatedAt, strconv.Itoa(q.Id)
+	})
+
 	questions := make([]domain.Question, 0, len(questionsDb))
 	for _, q := range questionsDb {
-		questions = append(questions, domain.Question{
-			Id:   q.Id,
-			Text: q.Text,
-		})
+		questions = append(questions, questionFromDto(&q))
+	}
+	if err := loadQuestionTags(r.db.WithContext(ctx), questions); err != nil {
+		return nil, nil, errors.Wrap(err, op)
 	}
 
-	return &questions, nil
+	return &questions, page, nil
 }
 
-func (r *Repository) CreateQuestion(ctx context.Context, question *string) (questionId int, err error) {
+func (r *Repository) CreateQuestion(ctx context.Context, question *domain.Question) (questionId int, err error) {
 	const op = "internal/infrastructure/db/repository.Repository.CreateQuestion"
 
 	newQuestion := dto.Question{
-		Text: *question,
-	}
-
-	result := r.db.WithContext(ctx).Create(&newQuestion)
-
-	if result.Error != nil {
-		return 0, errors.Wrap(result.Error, op)
+		UserId: &question.UserId,
+		Title:  question.Title,
+		Text:   question.Text,
 	}
 
-	if result.RowsAffected == 0 {
-		return 0, errors.Wrap(ErrNotFound, op)
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit("User").Create(&newQuestion)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		// исходный текст - первая ревизия вопроса
+		revision := dto.QuestionRevision{
+			QuestionId: newQuestion.Id,
+			EditorId:   newQuestion.UserId,
+			Title:      newQuestion.Title,
+			Text:       newQuestion.Text,
+			CreatedAt:  newQuestion.CreatedAt,
+		}
+		if err := translateError(tx.Create(&revision).Error); err != nil {
+			return err
+		}
+
+		if err := setQuestionTags(tx, newQuestion.Id, question.Tags); err != nil {
+			return err
+		}
+
+		return enqueueDeliveries(tx, domain.Event{
+			Type:       domain.EventQuestionCreated,
+			QuestionId: newQuestion.Id,
+			OccurredAt: newQuestion.CreatedAt,
+		})
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, op)
 	}
 
 	return newQuestion.Id, nil
@@ -121,43 +248,59 @@ func (r *Repository) ReadQuestionAndAnswers(ctx context.Context, questionId int)
 
 	result := r.db.WithContext(ctx).First(&questionDb, questionId)
 	if result.Error != nil {
-		return nil, nil, errors.Wrap(result.Error, op)
+		return nil, nil, errors.Wrap(translateError(result.Error), op)
 	}
 
-	result = r.db.WithContext(ctx).Where("question_id = ?", questionId).Find(&answersDb)
+	result = r.db.WithContext(ctx).
+		Where("question_id = ?", questionId).
+		Order("score DESC, created_at ASC, id ASC").
+		Find(&answersDb)
 	if result.Error != nil {
-		return nil, nil, errors.Wrap(result.Error, op)
+		return nil, nil, errors.Wrap(translateError(result.Error), op)
 	}
 
-	question := domain.Question{
-		Id:   questionDb.Id,
-		Text: questionDb.Text,
+	questions := []domain.Question{questionFromDto(&questionDb)}
+	if err := loadQuestionTags(r.db.WithContext(ctx), questions); err != nil {
+		return nil, nil, errors.Wrap(err, op)
 	}
 
 	answers := make([]domain.Answer, 0, len(answersDb))
 	for _, a := range answersDb {
-		answers = append(answers, domain.Answer{
-			Id:         a.Id,
-			QuestionId: a.QuestionId,
-			UserId:     a.UserId,
-			Text:       a.Text,
-		})
+		answers = append(answers, answerFromDto(&a))
 	}
 
-	return &question, &answers, nil
+	return &questions[0], &answers, nil
 }
 
+// DeleteQuestionAndAnswers мягко удаляет вопрос и его ответы с одной отметкой
+// deleted_at, чтобы восстановить их вместе.
 func (r *Repository) DeleteQuestionAndAnswers(ctx context.Context, questionId int) error {
-	const op = "internal/infrastructure/db/repository.Repository.ReadQuestionAndAnswers"
-
-	result := r.db.WithContext(ctx).Delete(&dto.Question{}, questionId)
-
-	if result.Error != nil {
-		return errors.Wrap(result.Error, op)
-	}
-
-	if result.RowsAffected == 0 {
-		return errors.Wrap(ErrNotFound, op)
+	const op = "internal/infrastructure/db/repository.Repository.DeleteQuestionAndAnswers"
+
+	now := time.Now()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&dto.Question{}).Where("id = ?", questionId).Update("deleted_at", now)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		result = tx.Model(&dto.Answer{}).Where("question_id = ?", questionId).Update("deleted_at", now)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		return enqueueDeliveries(tx, domain.Event{
+			Type:       domain.EventQuestionDeleted,
+			QuestionId: questionId,
+			OccurredAt: now,
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
 	}
 
 	return nil
@@ -172,14 +315,49 @@ func (r *Repository) CreateAnswerToQuestion(ctx context.Context, answer *domain.
 		Text:       answer.Text,
 	}
 
-	result := r.db.WithContext(ctx).Create(&answerDb)
-
-	if result.Error != nil {
-		return 0, errors.Wrap(result.Error, op)
-	}
-
-	if result.RowsAffected == 0 {
-		return 0, errors.Wrap(ErrNotFound, op)
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// блокировка FOR SHARE не дает сменить состояние вопроса до фиксации ответа,
+		// но не мешает отвечать на вопрос параллельно
+		var questionDb dto.Question
+		result := tx.Clauses(clause.Locking{Strength: "SHARE"}).Select("id", "status").First(&questionDb, answer.QuestionId)
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return domain.NewError(domain.ErrReferenceNotFound, "question not found")
+		}
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if status := domain.QuestionStatus(questionDb.Status); !status.AcceptsAnswers() {
+			return domain.NewError(domain.ErrConflict, "question is "+string(status)+" and does not accept answers")
+		}
+
+		result = tx.Create(&answerDb)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		// исходный текст - первая ревизия ответа
+		revision := dto.AnswerRevision{
+			AnswerId:  answerDb.Id,
+			EditorId:  &answerDb.UserId,
+			Text:      answerDb.Text,
+			CreatedAt: answerDb.CreatedAt,
+		}
+		if err := translateError(tx.Create(&revision).Error); err != nil {
+			return err
+		}
+
+		return enqueueDeliveries(tx, domain.Event{
+			Type:       domain.EventAnswerCreated,
+			QuestionId: answerDb.QuestionId,
+			AnswerId:   answerDb.Id,
+			OccurredAt: answerDb.CreatedAt,
+		})
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, op)
 	}
 
 	return answerDb.Id, nil
@@ -193,15 +371,10 @@ func (r *Repository) ReadAnswer(ctx context.Context, answerId int) (*domain.Answ
 	result := r.db.WithContext(ctx).First(&answerDb, answerId)
 
 	if result.Error != nil {
-		return nil, errors.Wrap(result.Error, op)
+		return nil, errors.Wrap(translateError(result.Error), op)
 	}
 
-	answer := domain.Answer{
-		Id:         answerDb.Id,
-		QuestionId: answerDb.QuestionId,
-		UserId:     answerDb.UserId,
-		Text:       answerDb.Text,
-	}
+	answer := answerFromDto(&answerDb)
 
 	return &answer, nil
 }
@@ -209,15 +382,75 @@ func (r *Repository) ReadAnswer(ctx context.Context, answerId int) (*domain.Answ
 func (r *Repository) DeleteAnswer(ctx context.Context, answerId int) error {
 	const op = "internal/infrastructure/db/repository.Repository.DeleteAnswer"
 
-	result := r.db.WithContext(ctx).Delete(&dto.Answer{}, answerId)
-
-	if result.Error != nil {
-		return errors.Wrap(result.Error, op)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var answerDb dto.Answer
+		result := tx.Select("id", "question_id").First(&answerDb, answerId)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		if err := reopenAcceptedQuestions(tx, answerId); err != nil {
+			return err
+		}
+
+		result = tx.Delete(&dto.Answer{}, answerId)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return enqueueDeliveries(tx, domain.Event{
+			Type:       domain.EventAnswerDeleted,
+			QuestionId: answerDb.QuestionId,
+			AnswerId:   answerId,
+			OccurredAt: time.Now(),
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
 	}
 
-	if result.RowsAffected == 0 {
-		return errors.Wrap(ErrNotFound, op)
+	return nil
+}
+
+// reopenAcceptedQuestions снимает принятие удаляемых ответов answerIds (значение
+// или подзапрос); вопросы в состоянии answered снова становятся открытыми.
+// Вопросы в корзине тоже обновляются, чтобы после восстановления статус
+// соответствовал принятому ответу.
+func reopenAcceptedQuestions(tx *gorm.DB, answerIds any) error {
+	result := tx.Unscoped().Model(&dto.Question{}).
+		Where("accepted_answer_id IN (?)", answerIds).
+		Updates(map[string]any{
+			"accepted_answer_id": nil,
+			"status":             gorm.Expr("CASE WHEN status = ? THEN ? ELSE status END", domain.QuestionStatusAnswered, domain.QuestionStatusOpen),
+		})
+	return translateError(result.Error)
+}
+
+func questionFromDto(questionDb *dto.Question) domain.Question {
+	question := domain.Question{
+		Id:               questionDb.Id,
+		Title:            questionDb.Title,
+		Text:             questionDb.Text,
+		Score:            questionDb.Score,
+		Status:           domain.QuestionStatus(questionDb.Status),
+		AcceptedAnswerId: questionDb.AcceptedAnswerId,
+		CreatedAt:        questionDb.CreatedAt,
 	}
+	if questionDb.UserId != nil {
+		question.UserId = *questionDb.UserId
+	}
+	return question
+}
 
-	return nil
+func answerFromDto(answerDb *dto.Answer) domain.Answer {
+	return domain.Answer{
+		Id:         answerDb.Id,
+		QuestionId: answerDb.QuestionId,
+		UserId:     answerDb.UserId,
+		Text:       answerDb.Text,
+		Score:      answerDb.Score,
+	}
 }
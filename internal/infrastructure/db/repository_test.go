@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/config"
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/usecase/repotest"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepository подключается к базе из переменных DB_* и применяет
+// миграции. Таблицы очищаются перед каждым тестом, поэтому тесты с базой
+// запускаются только при TEST_POSTGRES=1 на отдельной базе.
+func newTestRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	if os.Getenv("TEST_POSTGRES") != "1" {
+		t.Skip("set TEST_POSTGRES=1 and DB_* variables of a disposable database to run")
+	}
+
+	ctx := context.Background()
+	var cfg config.PostgreSQL
+	require.NoError(t, envconfig.Process("", &cfg))
+
+	repo, err := NewRepository(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { repo.Close() })
+
+	migrator, err := NewMigrator(repo)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	return repo
+}
+
+// resetTestDatabase удаляет все данные, кроме служебного пользователя.
+func resetTestDatabase(t *testing.T, repo *Repository) {
+	t.Helper()
+
+	// TRUNCATE ... CASCADE очищает и таблицы, ссылающиеся на перечисленные
+	require.NoError(t, repo.db.Exec(`TRUNCATE questions, tags, webhooks RESTART IDENTITY CASCADE`).Error)
+	require.NoError(t, repo.db.Exec(`DELETE FROM users WHERE id <> ?`, domain.DeletedUserId).Error)
+}
+
+// TestRepositoryContract проверяет db.Repository общим набором контрактных тестов.
+func TestRepositoryContract(t *testing.T) {
+	repo := newTestRepository(t)
+
+	repotest.Run(t, func(t *testing.T) repotest.Repository {
+		resetTestDatabase(t, repo)
+		return repo
+	})
+}
+
+func createTestUser(t *testing.T, repo *Repository, name string) string {
+	t.Helper()
+	userId, err := repo.CreateUser(context.Background(), &name, nil)
+	require.NoError(t, err)
+	return *userId
+}
+
+func createTestQuestion(t *testing.T, repo *Repository, userId, title, text string) int {
+	t.Helper()
+	questionId, err := repo.CreateQuestion(context.Background(), &domain.Question{
+		UserId: userId,
+		Title:  title,
+		Text:   text,
+	})
+	require.NoError(t, err)
+	return questionId
+}
+
+func createTestAnswer(t *testing.T, repo *Repository, questionId int, userId, text string) int {
+	t.Helper()
+	answerId, err := repo.CreateAnswerToQuestion(context.Background(), &domain.Answer{
+		QuestionId: questionId,
+		UserId:     userId,
+		Text:       text,
+	})
+	require.NoError(t, err)
+	return answerId
+}
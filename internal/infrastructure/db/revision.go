@@ -0,0 +1,181 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpdateQuestion применяет патч к вопросу и сохраняет новую ревизию.
+// Если заголовок и текст не меняются, ревизия не создается.
+func (r *Repository) UpdateQuestion(ctx context.Context, questionId int, patch *domain.QuestionPatch, editorId string) (*domain.Question, error) {
+	const op = "internal/infrastructure/db/revision.Repository.UpdateQuestion"
+
+	var questionDb dto.Question
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&questionDb, questionId)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		if patch.Tags != nil {
+			if err := setQuestionTags(tx, questionId, *patch.Tags); err != nil {
+				return err
+			}
+		}
+
+		changed := false
+		if patch.Title != nil && *patch.Title != questionDb.Title {
+			questionDb.Title = *patch.Title
+			changed = true
+		}
+		if patch.Text != nil && *patch.Text != questionDb.Text {
+			questionDb.Text = *patch.Text
+			changed = true
+		}
+		if !changed {
+			return nil
+		}
+
+		result = tx.Model(&questionDb).Updates(map[string]any{
+			"title": questionDb.Title,
+			"text":  questionDb.Text,
+		})
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		revision := dto.QuestionRevision{
+			QuestionId: questionDb.Id,
+			EditorId:   &editorId,
+			Title:      questionDb.Title,
+			Text:       questionDb.Text,
+		}
+		return translateError(tx.Create(&revision).Error)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	questions := []domain.Question{questionFromDto(&questionDb)}
+	if err := loadQuestionTags(r.db.WithContext(ctx), questions); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &questions[0], nil
+}
+
+// UpdateAnswer применяет патч к ответу и сохраняет новую ревизию.
+// Если патч ничего не меняет, ревизия не создается.
+func (r *Repository) UpdateAnswer(ctx context.Context, answerId int, patch *domain.AnswerPatch, editorId string) (*domain.Answer, error) {
+	const op = "internal/infrastructure/db/revision.Repository.UpdateAnswer"
+
+	var answerDb dto.Answer
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&answerDb, answerId)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		if patch.Text == nil || *patch.Text == answerDb.Text {
+			return nil
+		}
+		answerDb.Text = *patch.Text
+
+		result = tx.Model(&answerDb).Update("text", answerDb.Text)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		revision := dto.AnswerRevision{
+			AnswerId: answerDb.Id,
+			EditorId: &editorId,
+			Text:     answerDb.Text,
+		}
+		return translateError(tx.Create(&revision).Error)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	answer := answerFromDto(&answerDb)
+	return &answer, nil
+}
+
+func (r *Repository) ReadQuestionRevisions(ctx context.Context, questionId int) (*[]domain.Revision, error) {
+	const op = "internal/infrastructure/db/revision.Repository.ReadQuestionRevisions"
+
+	if err := r.exists(ctx, &dto.Question{}, questionId); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var revisionsDb []dto.QuestionRevision
+	result := r.db.WithContext(ctx).Where("question_id = ?", questionId).Order("id ASC").Find(&revisionsDb)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	revisions := make([]domain.Revision, 0, len(revisionsDb))
+	for _, rev := range revisionsDb {
+		revisions = append(revisions, domain.Revision{
+			Id:        rev.Id,
+			EditorId:  derefString(rev.EditorId),
+			Title:     rev.Title,
+			Text:      rev.Text,
+			CreatedAt: rev.CreatedAt,
+		})
+	}
+
+	return &revisions, nil
+}
+
+func (r *Repository) ReadAnswerRevisions(ctx context.Context, answerId int) (*[]domain.Revision, error) {
+	const op = "internal/infrastructure/db/revision.Repository.ReadAnswerRevisions"
+
+	if err := r.exists(ctx, &dto.Answer{}, answerId); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	var revisionsDb []dto.AnswerRevision
+	result := r.db.WithContext(ctx).Where("answer_id = ?", answerId).Order("id ASC").Find(&revisionsDb)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	revisions := make([]domain.Revision, 0, len(revisionsDb))
+	for _, rev := range revisionsDb {
+		revisions = append(revisions, domain.Revision{
+			Id:        rev.Id,
+			EditorId:  derefString(rev.EditorId),
+			Text:      rev.Text,
+			CreatedAt: rev.CreatedAt,
+		})
+	}
+
+	return &revisions, nil
+}
+
+// exists возвращает domain.ErrNotFound, если записи с таким первичным ключом нет.
+func (r *Repository) exists(ctx context.Context, model any, id any) error {
+	var count int64
+	result := r.db.WithContext(ctx).Model(model).Where("id = ?", id).Count(&count)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteRevisionEditor проверяет, что окончательное удаление автора правки
+// обнуляет editor_id, не нарушая неизменяемости остальных полей ревизии.
+func TestDeleteRevisionEditor(t *testing.T) {
+	repo := newTestRepository(t)
+	resetTestDatabase(t, repo)
+	ctx := context.Background()
+
+	authorId := createTestUser(t, repo, "author")
+	editorId := createTestUser(t, repo, "editor")
+	questionId := createTestQuestion(t, repo, authorId, "title", "text")
+	answerId := createTestAnswer(t, repo, questionId, authorId, "answer")
+
+	text := "edited"
+	_, err := repo.UpdateQuestion(ctx, questionId, &domain.QuestionPatch{Text: &text}, editorId)
+	require.NoError(t, err)
+	_, err = repo.UpdateAnswer(ctx, answerId, &domain.AnswerPatch{Text: &text}, editorId)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.db.Exec(`DELETE FROM users WHERE id = ?`, editorId).Error)
+
+	questionRevisions, err := repo.ReadQuestionRevisions(ctx, questionId)
+	require.NoError(t, err)
+	require.Len(t, *questionRevisions, 2)
+	assert.Equal(t, authorId, (*questionRevisions)[0].EditorId)
+	assert.Empty(t, (*questionRevisions)[1].EditorId)
+	assert.Equal(t, text, (*questionRevisions)[1].Text)
+
+	answerRevisions, err := repo.ReadAnswerRevisions(ctx, answerId)
+	require.NoError(t, err)
+	require.Len(t, *answerRevisions, 2)
+	assert.Empty(t, (*answerRevisions)[1].EditorId)
+
+	// остальные поля ревизии по-прежнему неизменяемы
+	err = repo.db.Exec(`UPDATE question_revisions SET text = 'forged' WHERE question_id = ?`, questionId).Error
+	assert.ErrorIs(t, translateError(err), domain.ErrValidation)
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultHeadlineLanguage = "russian"
+	headlineOptions         = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10"
+)
+
+// Выражения запроса для поиска без языка и с явно заданным языком. Выбираются
+// в Go: пустая строка в параметре типа regconfig отвергается Postgres еще при
+// привязке, до вычисления CASE.
+const (
+	bilingualQuerySQL = `websearch_to_tsquery('russian', @text) || websearch_to_tsquery('english', @text)`
+	languageQuerySQL  = `websearch_to_tsquery(@language::regconfig, @text)`
+)
+
+const searchSQL = `
+WITH query AS (
+	SELECT %s AS tsq
+)
+SELECT kind, id, question_id, rank, snippet
+FROM (
+	SELECT 'question' AS kind, q.id, q.id AS question_id, q.created_at,
+		ts_rank_cd(q.search_vector, query.tsq) AS rank,
+		ts_headline(@headline::regconfig, q.title || E'\n' || q.text, query.tsq, @options) AS snippet
+	FROM questions q, query
+	WHERE q.search_vector @@ query.tsq AND q.deleted_at IS NULL
+	UNION ALL
+	SELECT 'answer' AS kind, a.id, a.question_id, a.created_at,
+		ts_rank_cd(a.search_vector, query.tsq) AS rank,
+		ts_headline(@headline::regconfig, a.text, query.tsq, @options) AS snippet
+	FROM answers a, query
+	WHERE a.search_vector @@ query.tsq AND a.deleted_at IS NULL
+) hits
+ORDER BY rank DESC, created_at DESC, id DESC
+LIMIT @limit OFFSET @offset`
+
+type searchRow struct {
+	Kind       string
+	Id         int
+	QuestionId int
+	Rank       float64
+	Snippet    string
+}
+
+func (r *Repository) Search(ctx context.Context, query *domain.SearchQuery) (*[]domain.SearchHit, error) {
+	const op = "internal/infrastructure/db/search.Repository.Search"
+
+	args := map[string]any{
+		"text":     query.Text,
+		"headline": defaultHeadlineLanguage,
+		"options":  headlineOptions,
+		"limit":    query.Limit,
+		"offset":   query.Offset,
+	}
+	tsquery := bilingualQuerySQL
+	if query.Language != "" {
+		tsquery = languageQuerySQL
+		args["language"] = query.Language
+		args["headline"] = query.Language
+	}
+
+	var rows []searchRow
+	result := r.db.WithContext(ctx).Raw(fmt.Sprintf(searchSQL, tsquery), args).Scan(&rows)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	hits := make([]domain.SearchHit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, domain.SearchHit{
+			Kind:       row.Kind,
+			Id:         row.Id,
+			QuestionId: row.QuestionId,
+			Rank:       row.Rank,
+			Snippet:    row.Snippet,
+		})
+	}
+
+	return &hits, nil
+}
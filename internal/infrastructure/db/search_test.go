@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	repo := newTestRepository(t)
+	resetTestDatabase(t, repo)
+
+	userId := createTestUser(t, repo, "author")
+	questionId := createTestQuestion(t, repo, userId, "Goroutines", "How do goroutines leak?")
+	createTestAnswer(t, repo, questionId, userId, "Горутины утекают, если канал никто не читает")
+
+	tests := []struct {
+		name     string
+		query    domain.SearchQuery
+		expected []string
+	}{
+		{
+			name:     "without language",
+			query:    domain.SearchQuery{Text: "goroutines", Limit: 10},
+			expected: []string{"question"},
+		},
+		{
+			name:     "without language russian text",
+			query:    domain.SearchQuery{Text: "канал", Limit: 10},
+			expected: []string{"answer"},
+		},
+		{
+			name:     "explicit language",
+			query:    domain.SearchQuery{Text: "leaking", Language: "english", Limit: 10},
+			expected: []string{"question"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := repo.Search(context.Background(), &tt.query)
+			require.NoError(t, err)
+
+			kinds := make([]string, 0, len(*hits))
+			for _, hit := range *hits {
+				assert.Equal(t, questionId, hit.QuestionId)
+				kinds = append(kinds, hit.Kind)
+			}
+			assert.Equal(t, tt.expected, kinds)
+		})
+	}
+}
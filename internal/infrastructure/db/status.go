@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+)
+
+func (r *Repository) ReadQuestion(ctx context.Context, questionId int) (*domain.Question, error) {
+	const op = "internal/infrastructure/db/status.Repository.ReadQuestion"
+
+	var questionDb dto.Question
+
+	result := r.db.WithContext(ctx).First(&questionDb, questionId)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	questions := []domain.Question{questionFromDto(&questionDb)}
+	if err := loadQuestionTags(r.db.WithContext(ctx), questions); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &questions[0], nil
+}
+
+// UpdateQuestionStatus переводит вопрос из состояния from в to. Если состояние
+// уже изменил параллельный запрос, возвращается domain.ErrConflict.
+func (r *Repository) UpdateQuestionStatus(ctx context.Context, questionId int, from, to domain.QuestionStatus) (*domain.Question, error) {
+	const op = "internal/infrastructure/db/status.Repository.UpdateQuestionStatus"
+
+	result := r.db.WithContext(ctx).Model(&dto.Question{}).
+		Where("id = ? AND status = ?", questionId, from).
+		Update("status", to)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question status has been changed concurrently"), op)
+	}
+
+	question, err := r.ReadQuestion(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return question, nil
+}
+
+// AcceptAnswer отмечает ответ принятым и переводит вопрос в состояние answered.
+// Ответ должен относиться к этому вопросу, а вопрос - находиться в состоянии from.
+func (r *Repository) AcceptAnswer(ctx context.Context, questionId int, answerId int, from domain.QuestionStatus) (*domain.Question, error) {
+	const op = "internal/infrastructure/db/status.Repository.AcceptAnswer"
+
+	db := r.db.WithContext(ctx)
+	answerExists := db.Model(&dto.Answer{}).Select("1").Where("id = ? AND question_id = ?", answerId, questionId)
+
+	result := db.Model(&dto.Question{}).
+		Where("id = ? AND status = ? AND EXISTS (?)", questionId, from, answerExists).
+		Updates(map[string]any{
+			"accepted_answer_id": answerId,
+			"status":             domain.QuestionStatusAnswered,
+		})
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question or answer has been changed concurrently"), op)
+	}
+
+	question, err := r.ReadQuestion(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return question, nil
+}
@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPurgeAcceptedAnswerReopensQuestion проверяет, что окончательное удаление
+// принятого ответа возвращает вопрос в open, как и DeleteAnswer.
+func TestPurgeAcceptedAnswerReopensQuestion(t *testing.T) {
+	repo := newTestRepository(t)
+	resetTestDatabase(t, repo)
+	ctx := context.Background()
+
+	userId := createTestUser(t, repo, "author")
+	questionId := createTestQuestion(t, repo, userId, "title", "text")
+	answerId := createTestAnswer(t, repo, questionId, userId, "answer")
+
+	question, err := repo.AcceptAnswer(ctx, questionId, answerId, domain.QuestionStatusOpen)
+	require.NoError(t, err)
+	require.Equal(t, domain.QuestionStatusAnswered, question.Status)
+
+	// ответ попадает в корзину в обход DeleteAnswer, не снимая принятия
+	require.NoError(t, repo.db.Exec(`UPDATE answers SET deleted_at = ? WHERE id = ?`, time.Now().Add(-time.Hour), answerId).Error)
+
+	_, err = repo.PurgeDeleted(ctx, time.Now())
+	require.NoError(t, err)
+
+	question, err = repo.ReadQuestion(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.QuestionStatusOpen, question.Status)
+	assert.Nil(t, question.AcceptedAnswerId)
+}
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tagRow struct {
+	Name          string
+	QuestionCount int
+}
+
+// ReadTags возвращает используемые теги по убыванию числа вопросов.
+func (r *Repository) ReadTags(ctx context.Context, query *domain.TagQuery) (*[]domain.Tag, error) {
+	const op = "internal/infrastructure/db/tag.Repository.ReadTags"
+
+	var rows []tagRow
+	result := r.tagCounts(ctx).
+		Order("question_count DESC, t.name ASC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	tags := make([]domain.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, domain.Tag{Name: row.Name, QuestionCount: row.QuestionCount})
+	}
+
+	return &tags, nil
+}
+
+func (r *Repository) ReadTag(ctx context.Context, name string) (*domain.Tag, error) {
+	const op = "internal/infrastructure/db/tag.Repository.ReadTag"
+
+	var rows []tagRow
+	result := r.tagCounts(ctx).Where("t.name = ?", name).Scan(&rows)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+	if len(rows) == 0 {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	return &domain.Tag{Name: rows[0].Name, QuestionCount: rows[0].QuestionCount}, nil
+}
+
+// tagCounts - теги с числом вопросов; теги без вопросов и вопросы в корзине не учитываются.
+func (r *Repository) tagCounts(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("tags t").
+		Select("t.name, COUNT(*) AS question_count").
+		Joins("JOIN question_tags qt ON qt.tag_id = t.id").
+		Joins("JOIN questions q ON q.id = qt.question_id AND q.deleted_at IS NULL").
+		Group("t.id, t.name")
+}
+
+// filterByTags оставляет вопросы со всеми (TagMatchAll) или хотя бы
+// одним (TagMatchAny) из тегов фильтра.
+func filterByTags(query *gorm.DB, filter *domain.QuestionFilter) *gorm.DB {
+	if len(filter.Tags) == 0 {
+		return query
+	}
+
+	tagged := query.Session(&gorm.Session{NewDB: true}).
+		Table("question_tags qt").
+		Select("qt.question_id").
+		Joins("JOIN tags t ON t.id = qt.tag_id").
+		Where("t.name IN ?", filter.Tags)
+	if filter.TagMatch != domain.TagMatchAny {
+		tagged = tagged.Group("qt.question_id").Having("COUNT(*) = ?", len(filter.Tags))
+	}
+
+	return query.Where("id IN (?)", tagged)
+}
+
+// setQuestionTags заменяет теги вопроса, создавая недостающие теги.
+func setQuestionTags(tx *gorm.DB, questionId int, tags []string) error {
+	result := tx.Where("question_id = ?", questionId).Delete(&dto.QuestionTag{})
+	if result.Error != nil {
+		return translateDeleteError(result.Error)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	newTags := make([]dto.Tag, 0, len(tags))
+	for _, name := range tags {
+		newTags = append(newTags, dto.Tag{Name: name})
+	}
+	result = tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoNothing: true,
+	}).Create(&newTags)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+
+	var tagIds []int
+	result = tx.Model(&dto.Tag{}).Where("name IN ?", tags).Pluck("id", &tagIds)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+
+	links := make([]dto.QuestionTag, 0, len(tagIds))
+	for _, tagId := range tagIds {
+		links = append(links, dto.QuestionTag{QuestionId: questionId, TagId: tagId})
+	}
+	return translateError(tx.Create(&links).Error)
+}
+
+// loadQuestionTags заполняет теги у вопросов одним запросом.
+func loadQuestionTags(db *gorm.DB, questions []domain.Question) error {
+	if len(questions) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(questions))
+	for _, question := range questions {
+		ids = append(ids, question.Id)
+	}
+
+	var rows []struct {
+		QuestionId int
+		Name       string
+	}
+	result := db.Table("question_tags qt").
+		Select("qt.question_id, t.name").
+		Joins("JOIN tags t ON t.id = qt.tag_id").
+		Where("qt.question_id IN ?", ids).
+		Order("t.name ASC").
+		Scan(&rows)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+
+	tags := make(map[int][]string, len(questions))
+	for _, row := range rows {
+		tags[row.QuestionId] = append(tags[row.QuestionId], row.Name)
+	}
+	for i := range questions {
+		questions[i].Tags = tags[questions[i].Id]
+		if questions[i].Tags == nil {
+			questions[i].Tags = []string{}
+		}
+	}
+	return nil
+}
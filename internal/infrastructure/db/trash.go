@@ -0,0 +1,220 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const trashSQL = `
+SELECT kind, id, title, deleted_at
+FROM (
+	SELECT 'question' AS kind, id::text AS id, title, deleted_at
+	FROM questions
+	WHERE deleted_at IS NOT NULL
+	UNION ALL
+	SELECT 'answer' AS kind, id::text AS id, left(text, 200) AS title, deleted_at
+	FROM answers
+	WHERE deleted_at IS NOT NULL
+	UNION ALL
+	SELECT 'user' AS kind, id::text AS id, name AS title, deleted_at
+	FROM users
+	WHERE deleted_at IS NOT NULL
+) trash
+WHERE @kind = '' OR kind = @kind
+ORDER BY deleted_at DESC, kind ASC, id ASC
+LIMIT @limit OFFSET @offset`
+
+type trashRow struct {
+	Kind      string
+	Id        string
+	Title     string
+	DeletedAt time.Time
+}
+
+// ReadTrash возвращает мягко удаленные записи, начиная с последних удаленных.
+func (r *Repository) ReadTrash(ctx context.Context, query *domain.TrashQuery) (*[]domain.TrashItem, error) {
+	const op = "internal/infrastructure/db/trash.Repository.ReadTrash"
+
+	var rows []trashRow
+	result := r.db.WithContext(ctx).Raw(trashSQL, map[string]any{
+		"kind":   string(query.Kind),
+		"limit":  query.Limit,
+		"offset": query.Offset,
+	}).Scan(&rows)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	items := make([]domain.TrashItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, domain.TrashItem{
+			Kind:      domain.TrashKind(row.Kind),
+			Id:        row.Id,
+			Title:     row.Title,
+			DeletedAt: row.DeletedAt,
+		})
+	}
+
+	return &items, nil
+}
+
+// RestoreQuestion восстанавливает вопрос и ответы, удаленные вместе с ним.
+// Вопрос удаленного пользователя восстанавливается только вместе с пользователем.
+func (r *Repository) RestoreQuestion(ctx context.Context, questionId int) error {
+	const op = "internal/infrastructure/db/trash.Repository.RestoreQuestion"
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var questionDb dto.Question
+		result := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NOT NULL", questionId).
+			First(&questionDb)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		if questionDb.UserId != nil {
+			if err := requireAlive(tx, &dto.User{}, *questionDb.UserId, "question author is deleted"); err != nil {
+				return err
+			}
+		}
+
+		result = tx.Unscoped().Model(&dto.Question{}).Where("id = ?", questionId).Update("deleted_at", nil)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		result = tx.Unscoped().Model(&dto.Answer{}).
+			Where("question_id = ? AND deleted_at = ?", questionId, questionDb.DeletedAt.Time).
+			Update("deleted_at", nil)
+		return translateError(result.Error)
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// RestoreAnswer восстанавливает ответ. Вопрос и автор ответа не должны быть в корзине.
+func (r *Repository) RestoreAnswer(ctx context.Context, answerId int) error {
+	const op = "internal/infrastructure/db/trash.Repository.RestoreAnswer"
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var answerDb dto.Answer
+		result := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NOT NULL", answerId).
+			First(&answerDb)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		if err := requireAlive(tx, &dto.Question{}, answerDb.QuestionId, "question is deleted, restore the question first"); err != nil {
+			return err
+		}
+		if err := requireAlive(tx, &dto.User{}, answerDb.UserId, "answer author is deleted"); err != nil {
+			return err
+		}
+
+		result = tx.Unscoped().Model(&dto.Answer{}).Where("id = ?", answerId).Update("deleted_at", nil)
+		return translateError(result.Error)
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// RestoreUser восстанавливает пользователя вместе с вопросами и ответами,
+// удаленными при удалении пользователя.
+func (r *Repository) RestoreUser(ctx context.Context, userId *string) error {
+	const op = "internal/infrastructure/db/trash.Repository.RestoreUser"
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var userDb dto.User
+		result := tx.Unscoped().
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NOT NULL", *userId).
+			First(&userDb)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		deletedAt := userDb.DeletedAt.Time
+
+		result = tx.Unscoped().Model(&dto.User{}).Where("id = ?", *userId).Update("deleted_at", nil)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		userQuestions := tx.Unscoped().Model(&dto.Question{}).Select("id").Where("user_id = ?", *userId)
+		result = tx.Unscoped().Model(&dto.Answer{}).
+			Where("deleted_at = ? AND (user_id = ? OR question_id IN (?))", deletedAt, *userId, userQuestions).
+			Update("deleted_at", nil)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		result = tx.Unscoped().Model(&dto.Question{}).
+			Where("user_id = ? AND deleted_at = ?", *userId, deletedAt).
+			Update("deleted_at", nil)
+		return translateError(result.Error)
+	})
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+// PurgeDeleted окончательно удаляет записи, помещенные в корзину раньше before.
+// Связанные записи (голоса, ревизии, комментарии) удаляются каскадно.
+func (r *Repository) PurgeDeleted(ctx context.Context, before time.Time) (purged int64, err error) {
+	const op = "internal/infrastructure/db/trash.Repository.PurgeDeleted"
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// внешний ключ обнулил бы accepted_answer_id, оставив статус answered
+		purgedAnswers := tx.Unscoped().Model(&dto.Answer{}).Select("id").Where("deleted_at < ?", before)
+		if err := reopenAcceptedQuestions(tx, purgedAnswers); err != nil {
+			return err
+		}
+
+		for _, model := range []any{&dto.Answer{}, &dto.Question{}} {
+			result := tx.Unscoped().Where("deleted_at < ?", before).Delete(model)
+			if result.Error != nil {
+				return translateDeleteError(result.Error)
+			}
+			purged += result.RowsAffected
+		}
+
+		users, err := purgeUsers(tx, "deleted_at < ?", before)
+		purged += users
+		return err
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	return purged, nil
+}
+
+// requireAlive возвращает domain.ErrConflict с описанием desc, если запись
+// с первичным ключом id находится в корзине.
+func requireAlive(tx *gorm.DB, model any, id any, desc string) error {
+	var count int64
+	result := tx.Model(model).Where("id = ?", id).Count(&count)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if count == 0 {
+		return domain.NewError(domain.ErrConflict, desc)
+	}
+	return nil
+}
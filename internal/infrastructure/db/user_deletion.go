@@ -0,0 +1,118 @@
+package db
+
+import (
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"gorm.io/gorm"
+)
+
+// softDeleteUser помещает в корзину пользователя вместе с его вопросами и ответами,
+// а также ответами на его вопросы. Все записи получают одну отметку deleted_at.
+func softDeleteUser(tx *gorm.DB, userId string, now time.Time) (*domain.DeletedContent, error) {
+	result := tx.Model(&dto.User{}).Where("id = ?", userId).Update("deleted_at", now)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	// публикации, которые уйдут в корзину, выбираются до обновления deleted_at
+	var deleted domain.DeletedContent
+	result = tx.Model(&dto.Question{}).Where("user_id = ?", userId).Order("id").Pluck("id", &deleted.QuestionIds)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	var answersDb []dto.Answer
+	result = tx.Select("id", "question_id").
+		Where("user_id = ? AND question_id NOT IN (?)", userId, tx.Model(&dto.Question{}).Select("id").Where("user_id = ?", userId)).
+		Order("id").
+		Find(&answersDb)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	for _, answerDb := range answersDb {
+		deleted.Answers = append(deleted.Answers, domain.DeletedAnswer{Id: answerDb.Id, QuestionId: answerDb.QuestionId})
+	}
+
+	userQuestions := tx.Unscoped().Model(&dto.Question{}).Select("id").Where("user_id = ?", userId)
+	userAnswers := tx.Unscoped().Model(&dto.Answer{}).Select("id").Where("user_id = ?", userId)
+	if err := reopenAcceptedQuestions(tx, userAnswers); err != nil {
+		return nil, err
+	}
+
+	result = tx.Model(&dto.Question{}).Where("user_id = ?", userId).Update("deleted_at", now)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+	result = tx.Model(&dto.Answer{}).
+		Where("user_id = ? OR question_id IN (?)", userId, userQuestions).
+		Update("deleted_at", now)
+	if result.Error != nil {
+		return nil, translateError(result.Error)
+	}
+
+	for _, event := range deleted.Events(now) {
+		if err := enqueueDeliveries(tx, event); err != nil {
+			return nil, err
+		}
+	}
+	return &deleted, nil
+}
+
+// anonymizeUser передает публикации, комментарии и авторство правок пользователя
+// заглушке domain.DeletedUserId и окончательно удаляет пользователя.
+func anonymizeUser(tx *gorm.DB, userId string) error {
+	for _, model := range []any{&dto.Question{}, &dto.Answer{}, &dto.Comment{}} {
+		result := tx.Unscoped().Model(model).Where("user_id = ?", userId).Update("user_id", domain.DeletedUserId)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+	}
+	for _, model := range []any{&dto.QuestionRevision{}, &dto.AnswerRevision{}} {
+		result := tx.Model(model).Where("editor_id = ?", userId).Update("editor_id", domain.DeletedUserId)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+	}
+
+	_, err := purgeUsers(tx, "id = ?", userId)
+	return err
+}
+
+// requireNoContent возвращает domain.ErrConflict, если у пользователя есть
+// вопросы, ответы или комментарии вне корзины.
+func requireNoContent(tx *gorm.DB, userId string) error {
+	for _, model := range []any{&dto.Question{}, &dto.Answer{}, &dto.Comment{}} {
+		var count int64
+		result := tx.Model(model).Where("user_id = ?", userId).Limit(1).Count(&count)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if count > 0 {
+			return domain.NewError(domain.ErrConflict, "user has questions, answers or comments")
+		}
+	}
+	return nil
+}
+
+// purgeUsers окончательно удаляет пользователей, выбранных условием query.
+// Их голоса удаляются каскадно, поэтому рейтинг затронутых публикаций пересчитывается.
+func purgeUsers(tx *gorm.DB, query string, args ...any) (purged int64, err error) {
+	voted, err := votedPosts(tx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	result := tx.Unscoped().Where(query, args...).Delete(&dto.User{})
+	if result.Error != nil {
+		return 0, translateDeleteError(result.Error)
+	}
+
+	if err := recountVotedScores(tx, voted); err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteUserCascadeEnqueuesDeliveries проверяет, что удаление пользователя
+// ставит в очередь вебхуков события о вопросах и ответах, ушедших с ним в корзину.
+func TestDeleteUserCascadeEnqueuesDeliveries(t *testing.T) {
+	repo := newTestRepository(t)
+	resetTestDatabase(t, repo)
+	ctx := context.Background()
+
+	author := createTestUser(t, repo, "author")
+	other := createTestUser(t, repo, "other")
+	questionId := createTestQuestion(t, repo, author, "title", "text")
+	createTestAnswer(t, repo, questionId, other, "answer to author")
+	otherQuestionId := createTestQuestion(t, repo, other, "other", "text")
+	answerId := createTestAnswer(t, repo, otherQuestionId, author, "answer by author")
+
+	webhookId, err := repo.CreateWebhook(ctx, &domain.Webhook{
+		UserId: other,
+		URL:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []domain.EventType{domain.EventQuestionDeleted, domain.EventAnswerDeleted},
+	})
+	require.NoError(t, err)
+
+	_, err = repo.DeleteUser(ctx, &author, domain.UserDeletionCascade)
+	require.NoError(t, err)
+
+	deliveries, err := repo.ReadWebhookDeliveries(ctx, &domain.DeliveryQuery{WebhookId: webhookId, Limit: 10})
+	require.NoError(t, err)
+
+	var payloads []webhookPayload
+	for _, delivery := range *deliveries {
+		var payload webhookPayload
+		require.NoError(t, json.Unmarshal(delivery.Payload, &payload))
+		payloads = append(payloads, webhookPayload{Type: payload.Type, QuestionId: payload.QuestionId, AnswerId: payload.AnswerId})
+	}
+	assert.ElementsMatch(t, []webhookPayload{
+		{Type: string(domain.EventQuestionDeleted), QuestionId: questionId},
+		{Type: string(domain.EventAnswerDeleted), QuestionId: otherQuestionId, AnswerId: answerId},
+	}, payloads)
+}
@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// votable описывает таблицу публикации и таблицу голосов за нее.
+type votable struct {
+	table      string
+	votesTable string
+	column     string
+}
+
+var (
+	questionVotes = votable{table: "questions", votesTable: "question_votes", column: "question_id"}
+	answerVotes   = votable{table: "answers", votesTable: "answer_votes", column: "answer_id"}
+)
+
+func (r *Repository) VoteQuestion(ctx context.Context, questionId int, userId string, value domain.VoteValue) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/db/vote.Repository.VoteQuestion"
+
+	result, err := r.vote(ctx, questionVotes, questionId, userId, &value)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) RetractQuestionVote(ctx context.Context, questionId int, userId string) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/db/vote.Repository.RetractQuestionVote"
+
+	result, err := r.vote(ctx, questionVotes, questionId, userId, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) VoteAnswer(ctx context.Context, answerId int, userId string, value domain.VoteValue) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/db/vote.Repository.VoteAnswer"
+
+	result, err := r.vote(ctx, answerVotes, answerId, userId, &value)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) RetractAnswerVote(ctx context.Context, answerId int, userId string) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/db/vote.Repository.RetractAnswerVote"
+
+	result, err := r.vote(ctx, answerVotes, answerId, userId, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+// vote ставит, меняет (value != nil) или отзывает (value == nil) голос
+// и пересчитывает рейтинг публикации. Строка публикации блокируется,
+// чтобы параллельные голоса не теряли обновление рейтинга.
+func (r *Repository) vote(ctx context.Context, target votable, postId int, userId string, value *domain.VoteValue) (*domain.VoteResult, error) {
+	var score int
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked []int
+		result := tx.Table(target.table).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", postId).
+			Pluck("id", &locked)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if len(locked) == 0 {
+			return ErrNotFound
+		}
+
+		if value != nil {
+			now := time.Now()
+			result = tx.Table(target.votesTable).
+				Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: target.column}, {Name: "user_id"}},
+					DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+				}).
+				Create(map[string]any{
+					target.column: postId,
+					"user_id":     userId,
+					"value":       int(*value),
+					"created_at":  now,
+					"updated_at":  now,
+				})
+		} else {
+			result = tx.Exec("DELETE FROM "+target.votesTable+" WHERE "+target.column+" = ? AND user_id = ?", postId, userId)
+		}
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+
+		result = tx.Raw(
+			"UPDATE "+target.table+" SET score = (SELECT COALESCE(SUM(value), 0) FROM "+target.votesTable+" WHERE "+target.column+" = ?) WHERE id = ? RETURNING score",
+			postId, postId,
+		).Scan(&score)
+		return translateError(result.Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.VoteResult{Score: score, Vote: value}, nil
+}
+
+// votedPosts возвращает id публикаций, за которые голосовали пользователи,
+// выбранные условием query. Вызывается перед окончательным удалением пользователей:
+// их голоса удаляются каскадно, и рейтинг этих публикаций нужно пересчитать
+// через recountVotedScores.
+func votedPosts(tx *gorm.DB, query string, args ...any) (map[votable][]int, error) {
+	voted := make(map[votable][]int, 2)
+	for _, target := range []votable{questionVotes, answerVotes} {
+		users := tx.Unscoped().Model(&dto.User{}).Select("id").Where(query, args...)
+
+		var postIds []int
+		result := tx.Table(target.votesTable).
+			Distinct(target.column).
+			Where("user_id IN (?)", users).
+			Pluck(target.column, &postIds)
+		if result.Error != nil {
+			return nil, translateError(result.Error)
+		}
+		voted[target] = postIds
+	}
+	return voted, nil
+}
+
+// recountVotedScores пересчитывает рейтинг публикаций, найденных votedPosts.
+func recountVotedScores(tx *gorm.DB, voted map[votable][]int) error {
+	for target, postIds := range voted {
+		if err := recountScores(tx, target, postIds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recountScores пересчитывает рейтинг публикаций postIds по оставшимся голосам.
+func recountScores(tx *gorm.DB, target votable, postIds []int) error {
+	if len(postIds) == 0 {
+		return nil
+	}
+
+	result := tx.Exec(
+		"UPDATE "+target.table+" SET score = (SELECT COALESCE(SUM(value), 0) FROM "+target.votesTable+" v WHERE v."+target.column+" = "+target.table+".id) WHERE id IN ?",
+		postIds,
+	)
+	return translateError(result.Error)
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteVoterRecountsScores проверяет, что при окончательном удалении
+// пользователя рейтинг публикаций пересчитывается без его голосов.
+func TestDeleteVoterRecountsScores(t *testing.T) {
+	repo := newTestRepository(t)
+	resetTestDatabase(t, repo)
+	ctx := context.Background()
+
+	authorId := createTestUser(t, repo, "author")
+	anonymizedId := createTestUser(t, repo, "anonymized voter")
+	purgedId := createTestUser(t, repo, "purged voter")
+	questionId := createTestQuestion(t, repo, authorId, "title", "text")
+	answerId := createTestAnswer(t, repo, questionId, authorId, "answer")
+
+	for _, voterId := range []string{authorId, anonymizedId, purgedId} {
+		_, err := repo.VoteQuestion(ctx, questionId, voterId, domain.VoteUp)
+		require.NoError(t, err)
+		_, err = repo.VoteAnswer(ctx, answerId, voterId, domain.VoteDown)
+		require.NoError(t, err)
+	}
+
+	assertScores := func(t *testing.T, questionScore, answerScore int) {
+		t.Helper()
+		question, answers, err := repo.ReadQuestionAndAnswers(ctx, questionId)
+		require.NoError(t, err)
+		assert.Equal(t, questionScore, question.Score)
+		require.Len(t, *answers, 1)
+		assert.Equal(t, answerScore, (*answers)[0].Score)
+	}
+	assertScores(t, 3, -3)
+
+	_, err := repo.DeleteUser(ctx, &anonymizedId, domain.UserDeletionAnonymize)
+	require.NoError(t, err)
+	assertScores(t, 2, -2)
+
+	// мягкое удаление сохраняет голоса до очистки корзины
+	_, err = repo.DeleteUser(ctx, &purgedId, domain.UserDeletionCascade)
+	require.NoError(t, err)
+	assertScores(t, 2, -2)
+
+	_, err = repo.PurgeDeleted(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assertScores(t, 1, -1)
+}
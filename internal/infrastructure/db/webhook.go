@@ -0,0 +1,227 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/db/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// enqueueDeliveriesSQL ставит событие в очередь доставки каждой подписке,
+// в фильтр которой оно попадает.
+const enqueueDeliveriesSQL = `
+INSERT INTO webhook_deliveries (webhook_id, event_type, payload)
+SELECT id, @type, @payload::jsonb
+FROM webhooks
+WHERE events = '[]'::jsonb OR events @> jsonb_build_array(@type::text)`
+
+// claimDeliveriesSQL захватывает готовые к отправке доставки: увеличивает
+// счетчик попыток и откладывает следующую попытку на время аренды, чтобы
+// доставку, брошенную упавшим обработчиком, повторил другой.
+const claimDeliveriesSQL = `
+WITH claimed AS (
+	UPDATE webhook_deliveries
+	SET attempts = attempts + 1,
+		next_attempt_at = now() + @lease * interval '1 second'
+	WHERE id IN (
+		SELECT id
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= now()
+		ORDER BY next_attempt_at ASC, id ASC
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, webhook_id, event_type, payload, attempts
+)
+SELECT claimed.id AS delivery_id, claimed.event_type, claimed.payload::text AS payload,
+	claimed.attempts AS attempt, webhooks.url, webhooks.secret
+FROM claimed
+JOIN webhooks ON webhooks.id = claimed.webhook_id`
+
+type webhookPayload struct {
+	Type       string    `json:"type"`
+	QuestionId int       `json:"question_id"`
+	AnswerId   int       `json:"answer_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type webhookJobRow struct {
+	DeliveryId int64
+	EventType  string
+	Payload    string
+	Attempt    int
+	URL        string `gorm:"column:url"`
+	Secret     string
+}
+
+// enqueueDeliveries записывает доставки события в транзакции tx, породившей
+// событие: событие уходит подписчикам тогда и только тогда, когда запись зафиксирована.
+func enqueueDeliveries(tx *gorm.DB, event domain.Event) error {
+	payload, err := json.Marshal(webhookPayload{
+		Type:       string(event.Type),
+		QuestionId: event.QuestionId,
+		AnswerId:   event.AnswerId,
+		OccurredAt: event.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	result := tx.Exec(enqueueDeliveriesSQL, map[string]any{
+		"type":    string(event.Type),
+		"payload": string(payload),
+	})
+	return translateError(result.Error)
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, webhook *domain.Webhook) (webhookId int, err error) {
+	const op = "internal/infrastructure/db/webhook.Repository.CreateWebhook"
+
+	webhookDb := dto.Webhook{
+		UserId: webhook.UserId,
+		URL:    webhook.URL,
+		Secret: webhook.Secret,
+		Events: make([]string, 0, len(webhook.Events)),
+	}
+	for _, eventType := range webhook.Events {
+		webhookDb.Events = append(webhookDb.Events, string(eventType))
+	}
+
+	result := r.db.WithContext(ctx).Create(&webhookDb)
+	if result.Error != nil {
+		return 0, errors.Wrap(translateError(result.Error), op)
+	}
+
+	return webhookDb.Id, nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, webhookId int) error {
+	const op = "internal/infrastructure/db/webhook.Repository.DeleteWebhook"
+
+	result := r.db.WithContext(ctx).Delete(&dto.Webhook{}, webhookId)
+	if result.Error != nil {
+		return errors.Wrap(translateDeleteError(result.Error), op)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrap(ErrNotFound, op)
+	}
+
+	return nil
+}
+
+// ReadWebhookDeliveries возвращает доставки подписки, начиная с последних.
+func (r *Repository) ReadWebhookDeliveries(ctx context.Context, query *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error) {
+	const op = "internal/infrastructure/db/webhook.Repository.ReadWebhookDeliveries"
+
+	var count int64
+	result := r.db.WithContext(ctx).Model(&dto.Webhook{}).Where("id = ?", query.WebhookId).Count(&count)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+	if count == 0 {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	tx := r.db.WithContext(ctx).Where("webhook_id = ?", query.WebhookId)
+	if query.Status != "" {
+		tx = tx.Where("status = ?", string(query.Status))
+	}
+
+	var deliveriesDb []dto.WebhookDelivery
+	result = tx.Order("created_at DESC, id DESC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&deliveriesDb)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(deliveriesDb))
+	for i := range deliveriesDb {
+		deliveries = append(deliveries, deliveryFromDto(&deliveriesDb[i]))
+	}
+
+	return &deliveries, nil
+}
+
+// ClaimWebhookDeliveries захватывает до limit доставок, ожидающих отправки.
+// Доставка, не завершенная за lease, снова становится доступной.
+func (r *Repository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookJob, error) {
+	const op = "internal/infrastructure/db/webhook.Repository.ClaimWebhookDeliveries"
+
+	var rows []webhookJobRow
+	result := r.db.WithContext(ctx).Raw(claimDeliveriesSQL, map[string]any{
+		"lease": lease.Seconds(),
+		"limit": limit,
+	}).Scan(&rows)
+	if result.Error != nil {
+		return nil, errors.Wrap(translateError(result.Error), op)
+	}
+
+	jobs := make([]domain.WebhookJob, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, domain.WebhookJob{
+			DeliveryId: row.DeliveryId,
+			EventType:  domain.EventType(row.EventType),
+			Payload:    json.RawMessage(row.Payload),
+			Attempt:    row.Attempt,
+			URL:        row.URL,
+			Secret:     row.Secret,
+		})
+	}
+
+	return jobs, nil
+}
+
+// UpdateWebhookDelivery сохраняет итог попытки доставки.
+func (r *Repository) UpdateWebhookDelivery(ctx context.Context, deliveryId int64, deliveryResult *domain.DeliveryResult) error {
+	const op = "internal/infrastructure/db/webhook.Repository.UpdateWebhookDelivery"
+
+	updates := map[string]any{
+		"status":           string(deliveryResult.Status),
+		"last_status_code": deliveryResult.StatusCode,
+		"last_error":       nil,
+	}
+	if deliveryResult.Error != "" {
+		updates["last_error"] = deliveryResult.Error
+	}
+	switch deliveryResult.Status {
+	case domain.DeliveryStatusDelivered:
+		updates["delivered_at"] = time.Now()
+	case domain.DeliveryStatusPending:
+		updates["next_attempt_at"] = deliveryResult.NextAttemptAt
+	}
+
+	result := r.db.WithContext(ctx).Model(&dto.WebhookDelivery{}).Where("id = ?", deliveryId).Updates(updates)
+	if result.Error != nil {
+		return errors.Wrap(translateError(result.Error), op)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrap(ErrNotFound, op)
+	}
+
+	return nil
+}
+
+func deliveryFromDto(deliveryDb *dto.WebhookDelivery) domain.WebhookDelivery {
+	delivery := domain.WebhookDelivery{
+		Id:             deliveryDb.Id,
+		WebhookId:      deliveryDb.WebhookId,
+		EventType:      domain.EventType(deliveryDb.EventType),
+		Payload:        json.RawMessage(deliveryDb.Payload),
+		Status:         domain.DeliveryStatus(deliveryDb.Status),
+		Attempts:       deliveryDb.Attempts,
+		LastStatusCode: deliveryDb.LastStatusCode,
+		NextAttemptAt:  deliveryDb.NextAttemptAt,
+		CreatedAt:      deliveryDb.CreatedAt,
+		DeliveredAt:    deliveryDb.DeliveredAt,
+	}
+	if deliveryDb.LastError != nil {
+		delivery.LastError = *deliveryDb.LastError
+	}
+	return delivery
+}
@@ -0,0 +1,156 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (r *Repository) ReadUserCredentials(ctx context.Context, userName *string) (*domain.Credentials, error) {
+	const op = "internal/infrastructure/memory/auth.Repository.ReadUserCredentials"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if u.Name == *userName && u.deletedAt == nil {
+			return u.credentials(), nil
+		}
+	}
+
+	return nil, errors.Wrap(ErrNotFound, op)
+}
+
+func (r *Repository) ReadUserCredentialsById(ctx context.Context, userId *string) (*domain.Credentials, error) {
+	const op = "internal/infrastructure/memory/auth.Repository.ReadUserCredentialsById"
+
+	id, err := parseUUID(*userId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[id]
+	if !ok || u.deletedAt != nil {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	return u.credentials(), nil
+}
+
+func (r *Repository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	const op = "internal/infrastructure/memory/auth.Repository.CreateRefreshToken"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.insertRefreshToken(token); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadRefreshToken(ctx context.Context, tokenHash *string) (*domain.RefreshToken, error) {
+	const op = "internal/infrastructure/memory/auth.Repository.ReadRefreshToken"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, token := range r.refreshTokens {
+		if token.TokenHash == *tokenHash {
+			result := *token
+			return &result, nil
+		}
+	}
+
+	return nil, errors.Wrap(ErrNotFound, op)
+}
+
+// RotateRefreshToken атомарно отзывает действующий токен и сохраняет новый.
+// Если токен уже отозван, возвращает domain.ErrConflict.
+func (r *Repository) RotateRefreshToken(ctx context.Context, oldTokenId *string, newToken *domain.RefreshToken) error {
+	const op = "internal/infrastructure/memory/auth.Repository.RotateRefreshToken"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, ok := r.refreshTokens[*oldTokenId]
+	if !ok || old.RevokedAt != nil {
+		return errors.Wrap(domain.ErrConflict, op)
+	}
+	if err := r.insertRefreshToken(newToken); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	revokedAt := now()
+	old.RevokedAt = &revokedAt
+	return nil
+}
+
+func (r *Repository) RevokeRefreshToken(ctx context.Context, tokenId *string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if token, ok := r.refreshTokens[*tokenId]; ok && token.RevokedAt == nil {
+		revokedAt := now()
+		token.RevokedAt = &revokedAt
+	}
+
+	return nil
+}
+
+func (r *Repository) RevokeUserRefreshTokens(ctx context.Context, userId *string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	revokedAt := now()
+	for _, token := range r.refreshTokens {
+		if token.UserId == *userId && token.RevokedAt == nil {
+			token.RevokedAt = &revokedAt
+		}
+	}
+
+	return nil
+}
+
+// insertRefreshToken сохраняет токен с проверками таблицы refresh_tokens:
+// ссылка на пользователя и уникальность хеша. Назначает token.Id.
+func (r *Repository) insertRefreshToken(token *domain.RefreshToken) error {
+	userId, err := r.referenceUser(token.UserId)
+	if err != nil {
+		return err
+	}
+	for _, existing := range r.refreshTokens {
+		if existing.TokenHash == token.TokenHash {
+			return domain.NewError(domain.ErrConflict, "refresh token already exists")
+		}
+	}
+
+	stored := domain.RefreshToken{
+		Id:        uuid.NewString(),
+		UserId:    userId,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt.Truncate(time.Microsecond),
+	}
+	r.refreshTokens[stored.Id] = &stored
+
+	token.Id = stored.Id
+	return nil
+}
+
+func (u *userRow) credentials() *domain.Credentials {
+	credentials := domain.Credentials{
+		UserId: u.Id,
+		Name:   u.Name,
+	}
+	if u.passwordHash != nil {
+		credentials.PasswordHash = *u.passwordHash
+	}
+	return &credentials
+}
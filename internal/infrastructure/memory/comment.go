@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (r *Repository) CreateComment(ctx context.Context, comment *domain.Comment) (commentId int, err error) {
+	const op = "internal/infrastructure/memory/comment.Repository.CreateComment"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// внешние ключи не учитывают мягкое удаление, как и в Postgres
+	switch comment.Parent {
+	case domain.CommentParentQuestion:
+		if _, ok := r.questions[comment.ParentId]; !ok {
+			return 0, errors.Wrap(domain.NewError(domain.ErrReferenceNotFound, "question does not exist"), op)
+		}
+	case domain.CommentParentAnswer:
+		if _, ok := r.answers[comment.ParentId]; !ok {
+			return 0, errors.Wrap(domain.NewError(domain.ErrReferenceNotFound, "answer does not exist"), op)
+		}
+	default:
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown comment parent"), op)
+	}
+
+	userId, err := r.referenceUser(comment.UserId)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	if strings.Trim(comment.Text, " ") == "" {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "comment must not be blank"), op)
+	}
+	if utf8.RuneCountInString(comment.Text) > domain.MaxCommentLength {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "comment is too long"), op)
+	}
+
+	r.commentSeq++
+	r.comments[r.commentSeq] = &domain.Comment{
+		Id:        r.commentSeq,
+		Parent:    comment.Parent,
+		ParentId:  comment.ParentId,
+		UserId:    userId,
+		Text:      comment.Text,
+		CreatedAt: now(),
+	}
+
+	return r.commentSeq, nil
+}
+
+func (r *Repository) ReadComment(ctx context.Context, commentId int) (*domain.Comment, error) {
+	const op = "internal/infrastructure/memory/comment.Repository.ReadComment"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.comments[commentId]
+	if !ok {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	result := *c
+	return &result, nil
+}
+
+// ReadComments возвращает комментарии публикации в порядке создания.
+func (r *Repository) ReadComments(ctx context.Context, parent domain.CommentParent, parentId int) (*[]domain.Comment, error) {
+	const op = "internal/infrastructure/memory/comment.Repository.ReadComments"
+
+	if parent != domain.CommentParentQuestion && parent != domain.CommentParentAnswer {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown comment parent"), op)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.findComments(func(c *domain.Comment) bool {
+		return c.Parent == parent && c.ParentId == parentId
+	}), nil
+}
+
+// ReadThreadComments возвращает комментарии к вопросу и ко всем его ответам.
+func (r *Repository) ReadThreadComments(ctx context.Context, questionId int) (*[]domain.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.findComments(func(c *domain.Comment) bool {
+		if c.Parent == domain.CommentParentQuestion {
+			return c.ParentId == questionId
+		}
+		a, err := r.activeAnswer(c.ParentId)
+		return err == nil && a.QuestionId == questionId
+	}), nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, commentId int) error {
+	const op = "internal/infrastructure/memory/comment.Repository.DeleteComment"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.comments[commentId]; !ok {
+		return errors.Wrap(ErrNotFound, op)
+	}
+	delete(r.comments, commentId)
+
+	return nil
+}
+
+func (r *Repository) findComments(match func(*domain.Comment) bool) *[]domain.Comment {
+	comments := make([]domain.Comment, 0)
+	for _, c := range r.comments {
+		if match(c) {
+			comments = append(comments, *c)
+		}
+	}
+	slices.SortFunc(comments, func(a, b domain.Comment) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return a.Id - b.Id
+	})
+	return &comments
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+)
+
+// paginate повторяет keyset-пагинацию db: фильтры по дате создания, курсор
+// after и сортировка по (created_at, id). format переводит id в строку курсора.
+func paginate[T any, K cmp.Ordered](rows []T, params *domain.ListParams, after *K, key func(T) (time.Time, K), format func(K) string) ([]T, *domain.Page) {
+	compare := func(createdAt time.Time, id K, otherCreatedAt time.Time, otherId K) int {
+		if c := createdAt.Compare(otherCreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(id, otherId)
+	}
+
+	filtered := make([]T, 0, len(rows))
+	for _, row := range rows {
+		createdAt, id := key(row)
+		if params.CreatedAfter != nil && !createdAt.After(*params.CreatedAfter) {
+			continue
+		}
+		if params.CreatedBefore != nil && !createdAt.Before(*params.CreatedBefore) {
+			continue
+		}
+		if after != nil {
+			c := compare(createdAt, id, params.After.CreatedAt, *after)
+			if (params.Order == domain.SortAsc && c <= 0) || (params.Order != domain.SortAsc && c >= 0) {
+				continue
+			}
+		}
+		filtered = append(filtered, row)
+	}
+
+	slices.SortFunc(filtered, func(a, b T) int {
+		createdAt, id := key(a)
+		otherCreatedAt, otherId := key(b)
+		c := compare(createdAt, id, otherCreatedAt, otherId)
+		if params.Order != domain.SortAsc {
+			return -c
+		}
+		return c
+	})
+
+	if len(filtered) <= params.Limit {
+		return filtered, &domain.Page{}
+	}
+
+	filtered = filtered[:params.Limit]
+	createdAt, id := key(filtered[len(filtered)-1])
+	return filtered, &domain.Page{NextCursor: &domain.Cursor{CreatedAt: createdAt, Id: format(id)}}
+}
@@ -0,0 +1,529 @@
+// Package memory - хранилище в памяти процесса для тестов сервисов. Оно реализует
+// usecase.QNAManager, usecase.UserManager и usecase.CredentialsManager с той же
+// семантикой, что и db.Repository, и проходит общий набор repotest.
+//
+// Запустить на нем сервер нельзя: соответствие ролей и прав хранится в таблице
+// role_permissions, доставки вебхуков записываются в очередь в транзакции изменения,
+// а корзина, полнотекстовый поиск и счетчики метрик реализованы только в db.
+package memory
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNotFound = domain.ErrNotFound
+)
+
+type userRow struct {
+	domain.User
+	// passwordHash - nil у пользователей без пароля (служебная заглушка)
+	passwordHash *string
+	createdAt    time.Time
+	deletedAt    *time.Time
+}
+
+type questionRow struct {
+	domain.Question
+	deletedAt *time.Time
+	revisions []domain.Revision
+	votes     map[string]domain.VoteValue
+}
+
+type answerRow struct {
+	domain.Answer
+	createdAt time.Time
+	deletedAt *time.Time
+	revisions []domain.Revision
+	votes     map[string]domain.VoteValue
+}
+
+// Repository - потокобезопасная реализация usecase.QNAManager, usecase.UserManager
+// и usecase.CredentialsManager в памяти с той же семантикой, что и db.Repository:
+// мягкое удаление с каскадом на ответы, проверки внешних ключей
+// (domain.ErrReferenceNotFound), UUID пользователей и последовательные id публикаций.
+type Repository struct {
+	mu sync.RWMutex
+
+	users     map[string]*userRow
+	questions map[int]*questionRow
+	answers   map[int]*answerRow
+	comments  map[int]*domain.Comment
+	// refreshTokens - refresh-токены по id
+	refreshTokens map[string]*domain.RefreshToken
+
+	// последние выданные значения последовательностей id
+	questionSeq         int
+	answerSeq           int
+	commentSeq          int
+	questionRevisionSeq int
+	answerRevisionSeq   int
+}
+
+// NewRepository создает пустое хранилище со служебным пользователем
+// domain.DeletedUserId, как после применения миграций.
+func NewRepository() *Repository {
+	return &Repository{
+		users: map[string]*userRow{
+			domain.DeletedUserId: {
+				User:      domain.User{Id: domain.DeletedUserId, Name: "deleted user", Role: domain.RoleUser},
+				createdAt: now(),
+			},
+		},
+		questions:     make(map[int]*questionRow),
+		answers:       make(map[int]*answerRow),
+		comments:      make(map[int]*domain.Comment),
+		refreshTokens: make(map[string]*domain.RefreshToken),
+	}
+}
+
+func (r *Repository) CreateUser(ctx context.Context, userName *string, passwordHash *string) (userId *string, err error) {
+	const op = "internal/infrastructure/memory/repository.Repository.CreateUser"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkUserName(*userName); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	newUser := &userRow{
+		User:      domain.User{Id: uuid.NewString(), Name: *userName, Role: domain.RoleUser},
+		createdAt: now(),
+	}
+	if passwordHash != nil {
+		hash := *passwordHash
+		newUser.passwordHash = &hash
+	}
+	r.users[newUser.Id] = newUser
+
+	id := newUser.Id
+	return &id, nil
+}
+
+func (r *Repository) ReadUsers(ctx context.Context, params *domain.ListParams) (*[]domain.User, *domain.Page, error) {
+	const op = "internal/infrastructure/memory/repository.Repository.ReadUsers"
+
+	var after *string
+	if params.After != nil {
+		id, err := parseUUID(params.After.Id)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, op)
+		}
+		after = &id
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	active := make([]*userRow, 0, len(r.users))
+	for _, u := range r.users {
+		if u.deletedAt == nil && u.Id != domain.DeletedUserId {
+			active = append(active, u)
+		}
+	}
+
+	active, page := paginate(active, params, after, func(u *userRow) (time.Time, string) {
+		return u.createdAt, u.Id
+	}, func(id string) string {
+		return id
+	})
+
+	users := make([]domain.User, 0, len(active))
+	for _, u := range active {
+		users = append(users, u.User)
+	}
+
+	return &users, page, nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, userId *string, patch *domain.UserPatch) (*domain.User, error) {
+	const op = "internal/infrastructure/memory/repository.Repository.UpdateUser"
+
+	id, err := parseUUID(*userId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok || u.deletedAt != nil {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	if patch.Name != nil && *patch.Name != u.Name {
+		if err := r.checkUserName(*patch.Name); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		u.Name = *patch.Name
+	}
+	if patch.PasswordHash != nil {
+		passwordHash := *patch.PasswordHash
+		u.passwordHash = &passwordHash
+		revokedAt := now()
+		for _, token := range r.refreshTokens {
+			if token.UserId == u.Id && token.RevokedAt == nil {
+				token.RevokedAt = &revokedAt
+			}
+		}
+	}
+
+	result := u.User
+	return &result, nil
+}
+
+// DeleteUser удаляет пользователя по правилу policy так же, как db.Repository:
+// cascade мягко удаляет пользователя вместе с публикациями, anonymize передает
+// публикации заглушке domain.DeletedUserId и удаляет пользователя окончательно,
+// block отказывает, пока у пользователя есть публикации.
+func (r *Repository) DeleteUser(ctx context.Context, userId *string, policy domain.UserDeletionPolicy) (*domain.DeletedContent, error) {
+	const op = "internal/infrastructure/memory/repository.Repository.DeleteUser"
+
+	id, err := parseUUID(*userId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u, ok := r.users[id]; !ok || u.deletedAt != nil {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	switch policy {
+	case domain.UserDeletionAnonymize:
+		r.anonymizeUser(id)
+		return &domain.DeletedContent{}, nil
+	case domain.UserDeletionBlock:
+		if err := r.requireNoContent(id); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+
+	return r.softDeleteUser(id, now()), nil
+}
+
+func (r *Repository) ReadQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error) {
+	const op = "internal/infrastructure/memory/repository.Repository.ReadQuestions"
+
+	var after *int
+	if params.After != nil {
+		id, err := strconv.Atoi(params.After.Id)
+		if err != nil {
+			return nil, nil, errors.Wrap(domain.NewError(domain.ErrValidation, "invalid cursor"), op)
+		}
+		after = &id
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*questionRow, 0, len(r.questions))
+	for _, q := range r.questions {
+		if q.deletedAt != nil {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, q.Status) {
+			continue
+		}
+		if !matchTags(q.Tags, filter) {
+			continue
+		}
+		matched = append(matched, q)
+	}
+
+	matched, page := paginate(matched, params, after, func(q *questionRow) (time.Time, int) {
+		return q.CreatedAt, q.Id
+	}, strconv.Itoa)
+
+	questions := make([]domain.Question, 0, len(matched))
+	for _, q := range matched {
+		questions = append(questions, q.toDomain())
+	}
+
+	return &questions, page, nil
+}
+
+func (r *Repository) CreateQuestion(ctx context.Context, question *domain.Question) (questionId int, err error) {
+	const op = "internal/infrastructure/memory/repository.Repository.CreateQuestion"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userId, err := r.referenceUser(question.UserId)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	if err := checkTitle(question.Title); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	tags, err := checkTags(question.Tags)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
+	r.questionSeq++
+	r.questionRevisionSeq++
+	createdAt := now()
+	r.questions[r.questionSeq] = &questionRow{
+		Question: domain.Question{
+			Id:        r.questionSeq,
+			UserId:    userId,
+			Title:     question.Title,
+			Text:      question.Text,
+			Tags:      tags,
+			Status:    domain.QuestionStatusOpen,
+			CreatedAt: createdAt,
+		},
+		// исходный текст - первая ревизия вопроса
+		revisions: []domain.Revision{{
+			Id:        r.questionRevisionSeq,
+			EditorId:  userId,
+			Title:     question.Title,
+			Text:      question.Text,
+			CreatedAt: createdAt,
+		}},
+		votes: make(map[string]domain.VoteValue),
+	}
+
+	return r.questionSeq, nil
+}
+
+func (r *Repository) ReadQuestionAndAnswers(ctx context.Context, questionId int) (*domain.Question, *[]domain.Answer, error) {
+	const op = "internal/infrastructure/memory/repository.Repository.ReadQuestionAndAnswers"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, op)
+	}
+
+	matched := make([]*answerRow, 0)
+	for _, a := range r.answers {
+		if a.QuestionId == questionId && a.deletedAt == nil {
+			matched = append(matched, a)
+		}
+	}
+	slices.SortFunc(matched, func(a, b *answerRow) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		if c := a.createdAt.Compare(b.createdAt); c != 0 {
+			return c
+		}
+		return a.Id - b.Id
+	})
+
+	answers := make([]domain.Answer, 0, len(matched))
+	for _, a := range matched {
+		answers = append(answers, a.Answer)
+	}
+
+	result := q.toDomain()
+	return &result, &answers, nil
+}
+
+// DeleteQuestionAndAnswers мягко удаляет вопрос и его ответы с одной отметкой
+// удаления, чтобы восстановить их вместе.
+func (r *Repository) DeleteQuestionAndAnswers(ctx context.Context, questionId int) error {
+	const op = "internal/infrastructure/memory/repository.Repository.DeleteQuestionAndAnswers"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	deletedAt := now()
+	q.deletedAt = &deletedAt
+	for _, a := range r.answers {
+		if a.QuestionId == questionId && a.deletedAt == nil {
+			a.deletedAt = &deletedAt
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (answerId int, err error) {
+	const op = "internal/infrastructure/memory/repository.Repository.CreateAnswerToQuestion"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userId, err := r.referenceUser(answer.UserId)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	q, err := r.activeQuestion(answer.QuestionId)
+	if err != nil {
+		return 0, errors.Wrap(domain.NewError(domain.ErrReferenceNotFound, "question not found"), op)
+	}
+	if !q.Status.AcceptsAnswers() {
+		return 0, errors.Wrap(domain.NewError(domain.ErrConflict, "question is "+string(q.Status)+" and does not accept answers"), op)
+	}
+
+	r.answerSeq++
+	r.answerRevisionSeq++
+	createdAt := now()
+	r.answers[r.answerSeq] = &answerRow{
+		Answer: domain.Answer{
+			Id:         r.answerSeq,
+			QuestionId: answer.QuestionId,
+			UserId:     userId,
+			Text:       answer.Text,
+		},
+		createdAt: createdAt,
+		// исходный текст - первая ревизия ответа
+		revisions: []domain.Revision{{
+			Id:        r.answerRevisionSeq,
+			EditorId:  userId,
+			Text:      answer.Text,
+			CreatedAt: createdAt,
+		}},
+		votes: make(map[string]domain.VoteValue),
+	}
+
+	return r.answerSeq, nil
+}
+
+func (r *Repository) ReadAnswer(ctx context.Context, answerId int) (*domain.Answer, error) {
+	const op = "internal/infrastructure/memory/repository.Repository.ReadAnswer"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, err := r.activeAnswer(answerId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	result := a.Answer
+	return &result, nil
+}
+
+func (r *Repository) DeleteAnswer(ctx context.Context, answerId int) error {
+	const op = "internal/infrastructure/memory/repository.Repository.DeleteAnswer"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, err := r.activeAnswer(answerId)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	r.reopenAcceptedQuestions(map[int]bool{answerId: true})
+	deletedAt := now()
+	a.deletedAt = &deletedAt
+
+	return nil
+}
+
+// reopenAcceptedQuestions снимает принятие удаляемых ответов answerIds;
+// вопросы в состоянии answered снова становятся открытыми. Вопросы в корзине
+// тоже обновляются, как и в db.Repository.
+func (r *Repository) reopenAcceptedQuestions(answerIds map[int]bool) {
+	for _, q := range r.questions {
+		if q.AcceptedAnswerId == nil || !answerIds[*q.AcceptedAnswerId] {
+			continue
+		}
+		q.AcceptedAnswerId = nil
+		if q.Status == domain.QuestionStatusAnswered {
+			q.Status = domain.QuestionStatusOpen
+		}
+	}
+}
+
+func (r *Repository) activeQuestion(questionId int) (*questionRow, error) {
+	q, ok := r.questions[questionId]
+	if !ok || q.deletedAt != nil {
+		return nil, ErrNotFound
+	}
+	return q, nil
+}
+
+func (r *Repository) activeAnswer(answerId int) (*answerRow, error) {
+	a, ok := r.answers[answerId]
+	if !ok || a.deletedAt != nil {
+		return nil, ErrNotFound
+	}
+	return a, nil
+}
+
+// referenceUser проверяет ссылку на пользователя, как внешний ключ: пользователь
+// должен существовать, пусть даже в корзине. Возвращает id в каноническом виде.
+func (r *Repository) referenceUser(userId string) (string, error) {
+	id, err := parseUUID(userId)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := r.users[id]; !ok {
+		return "", domain.NewError(domain.ErrReferenceNotFound, "user does not exist")
+	}
+	return id, nil
+}
+
+// checkUserName повторяет ограничения таблицы users: длина имени и его
+// уникальность среди всех пользователей, включая удаленных в корзину.
+func (r *Repository) checkUserName(name string) error {
+	if utf8.RuneCountInString(name) > domain.MaxUserNameLength {
+		return domain.NewError(domain.ErrValidation, "user name is too long")
+	}
+	for _, u := range r.users {
+		if u.Name == name {
+			return domain.NewError(domain.ErrConflict, "user name is already taken")
+		}
+	}
+	return nil
+}
+
+func checkTitle(title string) error {
+	if strings.Trim(title, " ") == "" {
+		return domain.NewError(domain.ErrValidation, "question title must not be blank")
+	}
+	if utf8.RuneCountInString(title) > domain.MaxQuestionTitleLength {
+		return domain.NewError(domain.ErrValidation, "question title is too long")
+	}
+	return nil
+}
+
+func parseUUID(id string) (string, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return "", domain.NewError(domain.ErrValidation, "invalid UUID")
+	}
+	return parsed.String(), nil
+}
+
+func (q *questionRow) toDomain() domain.Question {
+	result := q.Question
+	result.Tags = slices.Clone(q.Tags)
+	if q.AcceptedAnswerId != nil {
+		acceptedAnswerId := *q.AcceptedAnswerId
+		result.AcceptedAnswerId = &acceptedAnswerId
+	}
+	return result
+}
+
+// now возвращает текущее время с точностью Postgres timestamptz.
+func now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/token"
+	"github.com/Vy4cheSlave/qna/internal/usecase"
+	"github.com/Vy4cheSlave/qna/internal/usecase/repotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repository {
+		return NewRepository()
+	})
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	name := "author"
+	author, err := repo.CreateUser(ctx, &name, nil)
+	require.NoError(t, err)
+	questionId, err := repo.CreateQuestion(ctx, &domain.Question{UserId: *author, Title: "question"})
+	require.NoError(t, err)
+
+	const workers = 50
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Go(func() {
+			name := fmt.Sprintf("voter-%d", i)
+			voter, err := repo.CreateUser(ctx, &name, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			_, err = repo.VoteQuestion(ctx, questionId, *voter, domain.VoteUp)
+			assert.NoError(t, err)
+			_, err = repo.CreateAnswerToQuestion(ctx, &domain.Answer{QuestionId: questionId, UserId: *voter, Text: "answer"})
+			assert.NoError(t, err)
+			_, _, err = repo.ReadQuestionAndAnswers(ctx, questionId)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	question, answers, err := repo.ReadQuestionAndAnswers(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, workers, question.Score)
+	assert.Len(t, *answers, workers)
+}
+
+// TestAuthFlow проходит вход, обновление и выход через usecase.Auth,
+// чтобы хранилище можно было использовать вместо базы и в сценариях с авторизацией.
+func TestAuthFlow(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	service := usecase.NewQNAManagerService(usecase.QNADeps{UserManager: repo})
+	name, password := "alice", "correct horse battery"
+	_, err := service.CreateUser(ctx, &name, &password)
+	require.NoError(t, err)
+
+	auth := usecase.NewAuthService(repo, token.NewManager([]byte("secret"), time.Minute), time.Hour)
+
+	wrong := "wrong password"
+	_, err = auth.Login(ctx, &name, &wrong)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+
+	tokens, err := auth.Login(ctx, &name, &password)
+	require.NoError(t, err)
+	assert.NotEmpty(t, tokens.AccessToken)
+
+	refreshed, err := auth.Refresh(ctx, &tokens.RefreshToken)
+	require.NoError(t, err)
+
+	// повторное предъявление отозванного токена отзывает все токены пользователя
+	_, err = auth.Refresh(ctx, &tokens.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	_, err = auth.Refresh(ctx, &refreshed.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+
+	tokens, err = auth.Login(ctx, &name, &password)
+	require.NoError(t, err)
+	require.NoError(t, auth.Logout(ctx, &tokens.RefreshToken))
+	_, err = auth.Refresh(ctx, &tokens.RefreshToken)
+	assert.ErrorIs(t, err, domain.ErrUnauthorized)
+}
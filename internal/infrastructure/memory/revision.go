@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+// UpdateQuestion применяет патч к вопросу и сохраняет новую ревизию.
+// Если заголовок и текст не меняются, ревизия не создается.
+func (r *Repository) UpdateQuestion(ctx context.Context, questionId int, patch *domain.QuestionPatch, editorId string) (*domain.Question, error) {
+	const op = "internal/infrastructure/memory/revision.Repository.UpdateQuestion"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	// все проверки выполняются до изменений, чтобы ошибка не оставила вопрос
+	// измененным наполовину
+	var tags []string
+	if patch.Tags != nil {
+		if tags, err = checkTags(*patch.Tags); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+
+	title, text := q.Title, q.Text
+	if patch.Title != nil {
+		title = *patch.Title
+	}
+	if patch.Text != nil {
+		text = *patch.Text
+	}
+	changed := title != q.Title || text != q.Text
+
+	var editor string
+	if changed {
+		if err := checkTitle(title); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		if editor, err = r.referenceUser(editorId); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+	}
+
+	if patch.Tags != nil {
+		q.Tags = tags
+	}
+	if changed {
+		q.Title, q.Text = title, text
+		r.questionRevisionSeq++
+		q.revisions = append(q.revisions, domain.Revision{
+			Id:        r.questionRevisionSeq,
+			EditorId:  editor,
+			Title:     title,
+			Text:      text,
+			CreatedAt: now(),
+		})
+	}
+
+	result := q.toDomain()
+	return &result, nil
+}
+
+// UpdateAnswer применяет патч к ответу и сохраняет новую ревизию.
+// Если патч ничего не меняет, ревизия не создается.
+func (r *Repository) UpdateAnswer(ctx context.Context, answerId int, patch *domain.AnswerPatch, editorId string) (*domain.Answer, error) {
+	const op = "internal/infrastructure/memory/revision.Repository.UpdateAnswer"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, err := r.activeAnswer(answerId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if patch.Text != nil && *patch.Text != a.Text {
+		editor, err := r.referenceUser(editorId)
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+
+		a.Text = *patch.Text
+		r.answerRevisionSeq++
+		a.revisions = append(a.revisions, domain.Revision{
+			Id:        r.answerRevisionSeq,
+			EditorId:  editor,
+			Text:      a.Text,
+			CreatedAt: now(),
+		})
+	}
+
+	result := a.Answer
+	return &result, nil
+}
+
+func (r *Repository) ReadQuestionRevisions(ctx context.Context, questionId int) (*[]domain.Revision, error) {
+	const op = "internal/infrastructure/memory/revision.Repository.ReadQuestionRevisions"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	revisions := slices.Clone(q.revisions)
+	return &revisions, nil
+}
+
+func (r *Repository) ReadAnswerRevisions(ctx context.Context, answerId int) (*[]domain.Revision, error) {
+	const op = "internal/infrastructure/memory/revision.Repository.ReadAnswerRevisions"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, err := r.activeAnswer(answerId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	revisions := slices.Clone(a.revisions)
+	return &revisions, nil
+}
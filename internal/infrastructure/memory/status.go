@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (r *Repository) ReadQuestion(ctx context.Context, questionId int) (*domain.Question, error) {
+	const op = "internal/infrastructure/memory/status.Repository.ReadQuestion"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	result := q.toDomain()
+	return &result, nil
+}
+
+// UpdateQuestionStatus переводит вопрос из состояния from в to. Если вопрос не
+// находится в состоянии from, возвращается domain.ErrConflict.
+func (r *Repository) UpdateQuestionStatus(ctx context.Context, questionId int, from, to domain.QuestionStatus) (*domain.Question, error) {
+	const op = "internal/infrastructure/memory/status.Repository.UpdateQuestionStatus"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil || q.Status != from {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question status has been changed concurrently"), op)
+	}
+	if !to.Valid() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown question status"), op)
+	}
+	q.Status = to
+
+	result := q.toDomain()
+	return &result, nil
+}
+
+// AcceptAnswer отмечает ответ принятым и переводит вопрос в состояние answered.
+// Ответ должен относиться к этому вопросу, а вопрос - находиться в состоянии from.
+func (r *Repository) AcceptAnswer(ctx context.Context, questionId int, answerId int, from domain.QuestionStatus) (*domain.Question, error) {
+	const op = "internal/infrastructure/memory/status.Repository.AcceptAnswer"
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil || q.Status != from {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question or answer has been changed concurrently"), op)
+	}
+	a, err := r.activeAnswer(answerId)
+	if err != nil || a.QuestionId != questionId {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question or answer has been changed concurrently"), op)
+	}
+
+	q.AcceptedAnswerId = &answerId
+	q.Status = domain.QuestionStatusAnswered
+
+	result := q.toDomain()
+	return &result, nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+// ReadTags возвращает используемые теги по убыванию числа вопросов.
+func (r *Repository) ReadTags(ctx context.Context, query *domain.TagQuery) (*[]domain.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := r.tagCounts()
+	tags := make([]domain.Tag, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, domain.Tag{Name: name, QuestionCount: count})
+	}
+	slices.SortFunc(tags, func(a, b domain.Tag) int {
+		if a.QuestionCount != b.QuestionCount {
+			return b.QuestionCount - a.QuestionCount
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	tags = tags[min(max(query.Offset, 0), len(tags)):]
+	if query.Limit >= 0 && query.Limit < len(tags) {
+		tags = tags[:query.Limit]
+	}
+
+	return &tags, nil
+}
+
+func (r *Repository) ReadTag(ctx context.Context, name string) (*domain.Tag, error) {
+	const op = "internal/infrastructure/memory/tag.Repository.ReadTag"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count, ok := r.tagCounts()[name]
+	if !ok {
+		return nil, errors.Wrap(ErrNotFound, op)
+	}
+
+	return &domain.Tag{Name: name, QuestionCount: count}, nil
+}
+
+// tagCounts - теги с числом вопросов; вопросы в корзине не учитываются.
+func (r *Repository) tagCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, q := range r.questions {
+		if q.deletedAt != nil {
+			continue
+		}
+		for _, tag := range q.Tags {
+			counts[tag]++
+		}
+	}
+	return counts
+}
+
+// matchTags сообщает, есть ли у вопроса все (TagMatchAll) или хотя бы
+// один (TagMatchAny) из тегов фильтра.
+func matchTags(tags []string, filter *domain.QuestionFilter) bool {
+	if len(filter.Tags) == 0 {
+		return true
+	}
+
+	matched := 0
+	for _, tag := range tags {
+		if slices.Contains(filter.Tags, tag) {
+			matched++
+		}
+	}
+	if filter.TagMatch == domain.TagMatchAny {
+		return matched > 0
+	}
+	return matched == len(filter.Tags)
+}
+
+// checkTags проверяет имена тегов по ограничениям таблицы tags и возвращает
+// их без повторов в порядке имен, как их читает db.Repository.
+func checkTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		normalized, err := domain.NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if normalized != tag {
+			return nil, domain.NewError(domain.ErrValidation, "tag \""+tag+"\" is not normalized")
+		}
+		if !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	slices.Sort(result)
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"slices"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+)
+
+// softDeleteUser помещает в корзину пользователя вместе с его вопросами и ответами,
+// а также ответами на его вопросы. Все записи получают одну отметку удаления.
+func (r *Repository) softDeleteUser(userId string, deletedAt time.Time) *domain.DeletedContent {
+	r.users[userId].deletedAt = &deletedAt
+
+	userAnswers := make(map[int]bool)
+	for _, a := range r.answers {
+		if a.UserId == userId {
+			userAnswers[a.Id] = true
+		}
+	}
+	r.reopenAcceptedQuestions(userAnswers)
+
+	var deleted domain.DeletedContent
+	for _, q := range r.questions {
+		if q.UserId == userId && q.deletedAt == nil {
+			q.deletedAt = &deletedAt
+			deleted.QuestionIds = append(deleted.QuestionIds, q.Id)
+		}
+	}
+	for _, a := range r.answers {
+		if a.deletedAt != nil {
+			continue
+		}
+		if r.questions[a.QuestionId].UserId == userId {
+			a.deletedAt = &deletedAt
+		} else if a.UserId == userId {
+			a.deletedAt = &deletedAt
+			deleted.Answers = append(deleted.Answers, domain.DeletedAnswer{Id: a.Id, QuestionId: a.QuestionId})
+		}
+	}
+
+	slices.Sort(deleted.QuestionIds)
+	slices.SortFunc(deleted.Answers, func(a, b domain.DeletedAnswer) int { return a.Id - b.Id })
+	return &deleted
+}
+
+// anonymizeUser передает публикации, комментарии и авторство правок пользователя
+// заглушке domain.DeletedUserId и окончательно удаляет пользователя вместе с его
+// голосами; рейтинг затронутых публикаций пересчитывается.
+func (r *Repository) anonymizeUser(userId string) {
+	for _, q := range r.questions {
+		if q.UserId == userId {
+			q.UserId = domain.DeletedUserId
+		}
+		anonymizeRevisions(q.revisions, userId)
+		if _, ok := q.votes[userId]; ok {
+			delete(q.votes, userId)
+			q.Score = score(q.votes)
+		}
+	}
+	for _, a := range r.answers {
+		if a.UserId == userId {
+			a.UserId = domain.DeletedUserId
+		}
+		anonymizeRevisions(a.revisions, userId)
+		if _, ok := a.votes[userId]; ok {
+			delete(a.votes, userId)
+			a.Score = score(a.votes)
+		}
+	}
+	for _, c := range r.comments {
+		if c.UserId == userId {
+			c.UserId = domain.DeletedUserId
+		}
+	}
+
+	// refresh-токены удаляются вместе с пользователем, как по внешнему ключу
+	for id, token := range r.refreshTokens {
+		if token.UserId == userId {
+			delete(r.refreshTokens, id)
+		}
+	}
+	delete(r.users, userId)
+}
+
+func anonymizeRevisions(revisions []domain.Revision, userId string) {
+	for i := range revisions {
+		if revisions[i].EditorId == userId {
+			revisions[i].EditorId = domain.DeletedUserId
+		}
+	}
+}
+
+// requireNoContent возвращает domain.ErrConflict, если у пользователя есть
+// вопросы, ответы или комментарии вне корзины.
+func (r *Repository) requireNoContent(userId string) error {
+	conflict := domain.NewError(domain.ErrConflict, "user has questions, answers or comments")
+	for _, q := range r.questions {
+		if q.UserId == userId && q.deletedAt == nil {
+			return conflict
+		}
+	}
+	for _, a := range r.answers {
+		if a.UserId == userId && a.deletedAt == nil {
+			return conflict
+		}
+	}
+	for _, c := range r.comments {
+		if c.UserId == userId {
+			return conflict
+		}
+	}
+	return nil
+}
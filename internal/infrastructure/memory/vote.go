@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (r *Repository) VoteQuestion(ctx context.Context, questionId int, userId string, value domain.VoteValue) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/memory/vote.Repository.VoteQuestion"
+
+	result, err := r.voteQuestion(questionId, userId, &value)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) RetractQuestionVote(ctx context.Context, questionId int, userId string) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/memory/vote.Repository.RetractQuestionVote"
+
+	result, err := r.voteQuestion(questionId, userId, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) VoteAnswer(ctx context.Context, answerId int, userId string, value domain.VoteValue) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/memory/vote.Repository.VoteAnswer"
+
+	result, err := r.voteAnswer(answerId, userId, &value)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) RetractAnswerVote(ctx context.Context, answerId int, userId string) (*domain.VoteResult, error) {
+	const op = "internal/infrastructure/memory/vote.Repository.RetractAnswerVote"
+
+	result, err := r.voteAnswer(answerId, userId, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (r *Repository) voteQuestion(questionId int, userId string, value *domain.VoteValue) (*domain.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, err := r.activeQuestion(questionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.vote(q.votes, userId, value); err != nil {
+		return nil, err
+	}
+	q.Score = score(q.votes)
+
+	return &domain.VoteResult{Score: q.Score, Vote: value}, nil
+}
+
+func (r *Repository) voteAnswer(answerId int, userId string, value *domain.VoteValue) (*domain.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, err := r.activeAnswer(answerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.vote(a.votes, userId, value); err != nil {
+		return nil, err
+	}
+	a.Score = score(a.votes)
+
+	return &domain.VoteResult{Score: a.Score, Vote: value}, nil
+}
+
+// vote ставит, меняет (value != nil) или отзывает (value == nil) голос
+// пользователя в votes.
+func (r *Repository) vote(votes map[string]domain.VoteValue, userId string, value *domain.VoteValue) error {
+	if value == nil {
+		id, err := parseUUID(userId)
+		if err != nil {
+			return err
+		}
+		delete(votes, id)
+		return nil
+	}
+
+	id, err := r.referenceUser(userId)
+	if err != nil {
+		return err
+	}
+	if !value.Valid() {
+		return domain.NewError(domain.ErrValidation, "vote value must be 1 or -1")
+	}
+	votes[id] = *value
+	return nil
+}
+
+func score(votes map[string]domain.VoteValue) int {
+	var sum int
+	for _, value := range votes {
+		sum += int(value)
+	}
+	return sum
+}
@@ -0,0 +1,223 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/pkg/errors"
+)
+
+func (t *serverAPI) Login(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.LoginRequest
+
+	// Десериализация JSON-запроса
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	if len(req.Name) == 0 || len(req.Password) == 0 {
+		errorList = append(errorList, errors.New("fields \"name\" and \"password\" must not be empty"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "fields \"name\" and \"password\" must not be empty"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	tokens, err := t.auth.Login(ctx, &req.Name, &req.Password)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(tokenResponse(tokens)),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) Refresh(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.RefreshTokenRequest
+
+	// Десериализация JSON-запроса
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	if len(req.RefreshToken) == 0 {
+		errorList = append(errorList, errors.New("field \"refresh_token\" must not be empty"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "field \"refresh_token\" must not be empty"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	tokens, err := t.auth.Refresh(ctx, &req.RefreshToken)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(tokenResponse(tokens)),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) Logout(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.RefreshTokenRequest
+
+	// Десериализация JSON-запроса
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	if len(req.RefreshToken) == 0 {
+		errorList = append(errorList, errors.New("field \"refresh_token\" must not be empty"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "field \"refresh_token\" must not be empty"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	err = t.auth.Logout(ctx, &req.RefreshToken)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func tokenResponse(tokens *domain.TokenPair) response.TokenResponse {
+	return response.TokenResponse{
+		AccessToken:           tokens.AccessToken,
+		TokenType:             "Bearer",
+		ExpiresIn:             int(time.Until(tokens.AccessTokenExpiresAt).Seconds()),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+	}
+}
@@ -0,0 +1,220 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/pkg/errors"
+)
+
+// includeComments - значение параметра include для встраивания комментариев
+// в ответ GET /questions/{id}.
+const includeComments = "comments"
+
+func (t *serverAPI) CreateQuestionComment(w http.ResponseWriter, r *http.Request) {
+	t.createComment(w, r, domain.CommentParentQuestion)
+}
+
+func (t *serverAPI) CreateAnswerComment(w http.ResponseWriter, r *http.Request) {
+	t.createComment(w, r, domain.CommentParentAnswer)
+}
+
+func (t *serverAPI) GetQuestionComments(w http.ResponseWriter, r *http.Request) {
+	t.getComments(w, r, domain.CommentParentQuestion)
+}
+
+func (t *serverAPI) GetAnswerComments(w http.ResponseWriter, r *http.Request) {
+	t.getComments(w, r, domain.CommentParentAnswer)
+}
+
+func (t *serverAPI) DeleteQuestionComment(w http.ResponseWriter, r *http.Request) {
+	t.deleteComment(w, r, domain.CommentParentQuestion)
+}
+
+func (t *serverAPI) DeleteAnswerComment(w http.ResponseWriter, r *http.Request) {
+	t.deleteComment(w, r, domain.CommentParentAnswer)
+}
+
+func (t *serverAPI) createComment(w http.ResponseWriter, r *http.Request, parent domain.CommentParent) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.CreateCommentRequest
+
+	// Десериализация JSON-запроса
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	parentId, validationErr := parsePathId(r)
+	if validationErr == "" && len(req.Text) == 0 {
+		validationErr = "field \"text\" must not be empty"
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	commentId, err := t.service.CreateComment(ctx, &domain.Comment{
+		Parent:   parent,
+		ParentId: parentId,
+		Text:     req.Text,
+	})
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(response.CreateCommentResponse{CommentId: commentId}),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) getComments(w http.ResponseWriter, r *http.Request, parent domain.CommentParent) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	parentId, validationErr := parsePathId(r)
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	comments, err := t.service.GetComments(ctx, parent, parentId)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*comments),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) deleteComment(w http.ResponseWriter, r *http.Request, parent domain.CommentParent) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	parentId, validationErr := parsePathId(r)
+	commentId, err := strconv.Atoi(r.PathValue("commentId"))
+	if validationErr == "" && err != nil {
+		validationErr = "invalid ID format for \"commentId\""
+	}
+	if validationErr == "" && commentId < 1 {
+		validationErr = "ID must be a positive integer"
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	err = t.service.DeleteComment(ctx, parent, parentId, commentId)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
@@ -0,0 +1,56 @@
+package request
+
+import "encoding/json"
+
+// Optional - поле документа JSON Merge Patch (RFC 7396). Отсутствующее поле
+// не меняется, null удаляет значение, иначе значение заменяется.
+type Optional[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &o.Value)
+}
+
+// MarshalJSON кодирует заданное поле: null, если значение удаляется.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+// IsZero сообщает, что поле не задано; с тегом omitzero оно не попадает в документ.
+func (o Optional[T]) IsZero() bool {
+	return !o.Set
+}
+
+// Ptr возвращает значение для патча предметной области: nil, если поле не задано.
+func (o *Optional[T]) Ptr() *T {
+	if !o.Set || o.Null {
+		return nil
+	}
+	return &o.Value
+}
+
+type PatchQuestionRequest struct {
+	Title Optional[string]   `json:"title,omitzero"`
+	Text  Optional[string]   `json:"text,omitzero"`
+	Tags  Optional[[]string] `json:"tags,omitzero"`
+}
+
+type PatchAnswerRequest struct {
+	Text Optional[string] `json:"text,omitzero"`
+}
+
+type PatchUserRequest struct {
+	Name     Optional[string] `json:"name,omitzero"`
+	Password Optional[string] `json:"password,omitzero"`
+}
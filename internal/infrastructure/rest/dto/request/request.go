@@ -1,14 +1,44 @@
 package request
 
 type CreateUserRequest struct {
-	Name string `json:"name"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
 }
 
 type CreateQuestionRequest struct {
-	Text string `json:"text"`
+	Title string   `json:"title"`
+	Text  string   `json:"text"`
+	Tags  []string `json:"tags"`
 }
 
 type CreateAnswerToQuestionRequest struct {
 	UserId string `json:"user_id"`
 	Text   string `json:"text"`
 }
+
+type LoginRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type VoteRequest struct {
+	Value int `json:"value"`
+}
+
+type ChangeQuestionStatusRequest struct {
+	Status string `json:"status"`
+}
+
+type CreateCommentRequest struct {
+	Text string `json:"text"`
+}
+
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
+}
@@ -3,6 +3,7 @@ package response
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/Vy4cheSlave/qna/internal/domain"
 	"github.com/pkg/errors"
@@ -10,19 +11,29 @@ import (
 
 // Error Codes
 const (
-	// ErrCodeInvalidToken        = "INVALID_TOKEN"
-	// ErrCodeMissingAuthHeader   = "MISSING_AUTH_HEADER"
+	ErrCodeInvalidToken        = "INVALID_TOKEN"
+	ErrCodeMissingAuthHeader   = "MISSING_AUTH_HEADER"
 	ErrCodeValidationFailed    = "VALIDATION_FAILED"
 	ErrCodeJsonParsingFailed   = "JSON_PARSING_FAILED"
 	ErrCodeInternalServerError = "INTERNAL_SERVER_ERROR"
-	// ErrCodeUnauthorized        = "UNAUTHORIZED"
-	// ErrCodeNotFound            = "NOT_FOUND"
+	ErrCodeUnauthorized        = "UNAUTHORIZED"
+	ErrCodeNotFound            = "NOT_FOUND"
+	ErrCodeConflict            = "CONFLICT"
+	ErrCodeReferenceNotFound   = "REFERENCE_NOT_FOUND"
+	ErrCodeForbidden           = "FORBIDDEN"
+	ErrCodeUnsupportedMedia    = "UNSUPPORTED_MEDIA_TYPE"
 )
 
 type Response struct {
 	Status string `json:"status"`
 	Error  *Error `json:"error,omitempty"`
 	Data   any    `json:"data,omitempty"`
+	Meta   *Meta  `json:"meta,omitempty"`
+}
+
+type Meta struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
 }
 
 type Error struct {
@@ -60,6 +71,12 @@ func WithData(data any) Option {
 	}
 }
 
+func WithMeta(meta *Meta) Option {
+	return func(r *Response) {
+		r.Meta = meta
+	}
+}
+
 // схемы
 type CreateUserResponse struct {
 	UserId string `json:"user_id"`
@@ -72,8 +89,39 @@ type CreateQuestionResponse struct {
 type GetQuestionAndAnswersResponse struct {
 	Question domain.Question `json:"question"`
 	Answers  []domain.Answer `json:"answers"`
+	// Comments - комментарии к вопросу и ответам, только при include=comments
+	Comments *[]domain.Comment `json:"comments,omitempty"`
 }
 
 type CreateAnswerToQuestionResponse struct {
 	AnswerId int `json:"answer_id"`
 }
+
+type CreateCommentResponse struct {
+	CommentId int `json:"comment_id"`
+}
+
+type CreateWebhookResponse struct {
+	WebhookId int `json:"webhook_id"`
+}
+
+type VoteResponse struct {
+	Score int  `json:"score"`
+	Vote  *int `json:"vote"`
+}
+
+type TokenResponse struct {
+	AccessToken           string    `json:"access_token"`
+	TokenType             string    `json:"token_type"`
+	ExpiresIn             int       `json:"expires_in"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+// EventResponse - данные события потока SSE; Id события передается в поле id потока.
+type EventResponse struct {
+	Type       string    `json:"type"`
+	QuestionId int       `json:"question_id"`
+	AnswerId   int       `json:"answer_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
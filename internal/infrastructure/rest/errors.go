@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+// serviceError сопоставляет ошибку сервиса с HTTP-статусом и кодом ошибки ответа.
+func serviceError(err error) (httpStatus int, code string, desc string) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		httpStatus, code, desc = http.StatusNotFound, response.ErrCodeNotFound, "resource not found"
+	case errors.Is(err, domain.ErrReferenceNotFound):
+		httpStatus, code, desc = http.StatusUnprocessableEntity, response.ErrCodeReferenceNotFound, "referenced resource not found"
+	case errors.Is(err, domain.ErrConflict):
+		httpStatus, code, desc = http.StatusConflict, response.ErrCodeConflict, "resource conflict"
+	case errors.Is(err, domain.ErrValidation):
+		httpStatus, code, desc = http.StatusUnprocessableEntity, response.ErrCodeValidationFailed, "validation failed"
+	case errors.Is(err, domain.ErrUnauthorized):
+		httpStatus, code, desc = http.StatusUnauthorized, response.ErrCodeUnauthorized, "unauthorized"
+	case errors.Is(err, domain.ErrForbidden):
+		httpStatus, code, desc = http.StatusForbidden, response.ErrCodeForbidden, "forbidden"
+	default:
+		return http.StatusInternalServerError, response.ErrCodeInternalServerError, "internal server error"
+	}
+
+	// описание, заданное на уровне предметной области, точнее общего
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		desc = domainErr.Desc
+	}
+
+	return httpStatus, code, desc
+}
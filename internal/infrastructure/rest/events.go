@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultHeartbeatInterval = 15 * time.Second
+)
+
+// EventSubscriber - источник доменных событий для потоков Server-Sent Events.
+type EventSubscriber interface {
+	Subscribe(afterId int64, match func(domain.Event) bool) (missed []domain.Event, events <-chan domain.Event, cancel func())
+}
+
+func (t *serverAPI) GetEvents(w http.ResponseWriter, r *http.Request) {
+	t.streamEvents(w, r, nil)
+}
+
+func (t *serverAPI) GetQuestionEvents(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	questionId, validationErr := parsePathId(r)
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	t.streamEvents(w, r, func(event domain.Event) bool {
+		return event.QuestionId == questionId
+	})
+}
+
+// streamEvents отдает поток событий, отобранных match, в формате text/event-stream.
+// Клиент, передавший Last-Event-ID, сначала получает пропущенные события из буфера.
+func (t *serverAPI) streamEvents(w http.ResponseWriter, r *http.Request, match func(domain.Event) bool) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	var afterId int64
+	if lastEventId := r.Header.Get("Last-Event-ID"); lastEventId != "" {
+		id, err := strconv.ParseInt(lastEventId, 10, 64)
+		if err != nil || id < 0 {
+			validationErr := "invalid \"Last-Event-ID\" header"
+			errorList = append(errorList, errors.New(validationErr))
+			err := response.ReturnResponse(
+				w,
+				http.StatusBadRequest,
+				response.WithError(response.ErrCodeValidationFailed, validationErr),
+			)
+			if err != nil {
+				errorList = append(errorList, err)
+			}
+			middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+			return
+		}
+		afterId = id
+	}
+
+	// поток живет дольше WriteTimeout сервера
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusInternalServerError,
+			response.WithError(response.ErrCodeInternalServerError, "streaming is not supported"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		return
+	}
+
+	missed, events, cancel := t.events.Subscribe(afterId, match)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	err := func() error {
+		for _, event := range missed {
+			if err := writeEvent(w, event); err != nil {
+				return err
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return err
+		}
+
+		heartbeatInterval := t.heartbeat
+		if heartbeatInterval <= 0 {
+			heartbeatInterval = defaultHeartbeatInterval
+		}
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.shutdown:
+				return nil
+			case event, ok := <-events:
+				// канал закрыт, если клиент не успевал читать: он переподключится с Last-Event-ID
+				if !ok {
+					return nil
+				}
+				if err := writeEvent(w, event); err != nil {
+					return err
+				}
+			case <-heartbeat.C:
+				if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+					return err
+				}
+			}
+			if err := controller.Flush(); err != nil {
+				return err
+			}
+		}
+	}()
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func writeEvent(w io.Writer, event domain.Event) error {
+	data, err := json.Marshal(response.EventResponse{
+		Type:       string(event.Type),
+		QuestionId: event.QuestionId,
+		AnswerId:   event.AnswerId,
+		OccurredAt: event.OccurredAt,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode event")
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+	return err
+}
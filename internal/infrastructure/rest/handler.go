@@ -4,73 +4,151 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/health"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/Vy4cheSlave/qna/internal/metrics"
 	"github.com/google/uuid"
 
 	"github.com/pkg/errors"
 )
 
 type QNADispatcher interface {
-	CreateUser(ctx context.Context, userName *string) (userId *string, err error)
-	GetUsers(ctx context.Context) (*[]domain.User, error)
+	CreateUser(ctx context.Context, userName *string, password *string) (userId *string, err error)
+	GetUsers(ctx context.Context, params *domain.ListParams) (*[]domain.User, *domain.Page, error)
 	DeleteUser(ctx context.Context, userId *string) error
-	GetQuestions(ctx context.Context) (*[]domain.Question, error)
-	CreateQuestion(ctx context.Context, question *string) (questionId int, err error)
+	GetQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error)
+	CreateQuestion(ctx context.Context, question *domain.Question) (questionId int, err error)
 	GetQuestionAndAnswers(ctx context.Context, questionId int) (*domain.Question, *[]domain.Answer, error)
 	DeleteQuestionAndAnswers(ctx context.Context, questionId int) error
 	CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (answerId int, err error)
 	GetAnswer(ctx context.Context, answerId int) (*domain.Answer, error)
 	DeleteAnswer(ctx context.Context, answerId int) error
+	Search(ctx context.Context, query *domain.SearchQuery) (*[]domain.SearchHit, error)
+	UpdateUser(ctx context.Context, userId *string, patch *domain.UserPatch) (*domain.User, error)
+	UpdateQuestion(ctx context.Context, questionId int, patch *domain.QuestionPatch) (*domain.Question, error)
+	UpdateAnswer(ctx context.Context, answerId int, patch *domain.AnswerPatch) (*domain.Answer, error)
+	GetQuestionRevisions(ctx context.Context, questionId int) (*[]domain.Revision, error)
+	GetAnswerRevisions(ctx context.Context, answerId int) (*[]domain.Revision, error)
+	VoteQuestion(ctx context.Context, questionId int, value domain.VoteValue) (*domain.VoteResult, error)
+	RetractQuestionVote(ctx context.Context, questionId int) (*domain.VoteResult, error)
+	VoteAnswer(ctx context.Context, answerId int, value domain.VoteValue) (*domain.VoteResult, error)
+	RetractAnswerVote(ctx context.Context, answerId int) (*domain.VoteResult, error)
+	AcceptAnswer(ctx context.Context, questionId int, answerId int) (*domain.Question, error)
+	ChangeQuestionStatus(ctx context.Context, questionId int, status domain.QuestionStatus) (*domain.Question, error)
+	GetTags(ctx context.Context, query *domain.TagQuery) (*[]domain.Tag, error)
+	GetTagQuestions(ctx context.Context, name string, params *domain.ListParams) (*[]domain.Question, *domain.Page, error)
+	CreateComment(ctx context.Context, comment *domain.Comment) (commentId int, err error)
+	GetComments(ctx context.Context, parent domain.CommentParent, parentId int) (*[]domain.Comment, error)
+	GetThreadComments(ctx context.Context, questionId int) (*[]domain.Comment, error)
+	DeleteComment(ctx context.Context, parent domain.CommentParent, parentId int, commentId int) error
+	GetTrash(ctx context.Context, query *domain.TrashQuery) (*[]domain.TrashItem, error)
+	RestoreQuestion(ctx context.Context, questionId int) error
+	RestoreAnswer(ctx context.Context, answerId int) error
+	RestoreUser(ctx context.Context, userId *string) error
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) (webhookId int, err error)
+	DeleteWebhook(ctx context.Context, webhookId int) error
+	GetWebhookDeliveries(ctx context.Context, query *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error)
+}
+
+type AuthDispatcher interface {
+	Login(ctx context.Context, userName *string, password *string) (*domain.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken *string) (*domain.TokenPair, error)
+	Logout(ctx context.Context, refreshToken *string) error
 }
 
 type Server struct {
-	log        *slog.Logger
-	service    QNADispatcher
-	restServer *http.Server
-	addr       *string
+	log             *slog.Logger
+	service         QNADispatcher
+	restServer      *http.Server
+	addr            *string
+	ready           *atomic.Bool
+	shutdownTimeout time.Duration
 }
 
 type serverAPI struct {
-	addr    *string
-	log     *slog.Logger
-	service QNADispatcher
+	addr      *string
+	log       *slog.Logger
+	service   QNADispatcher
+	auth      AuthDispatcher
+	tokens    middleware.AccessTokenParser
+	health    *health.Registry
+	metrics   *metrics.Registry
+	events    EventSubscriber
+	heartbeat time.Duration
+	// shutdown закрывается при остановке сервера, чтобы завершить потоки событий
+	shutdown chan struct{}
+}
+
+// ServerDeps - зависимости HTTP-сервера. Поля, помеченные как необязательные,
+// могут оставаться нулевыми.
+type ServerDeps struct {
+	Log             *slog.Logger
+	Service         QNADispatcher
+	Auth            AuthDispatcher
+	Tokens          middleware.AccessTokenParser
+	Addr            *string
+	ShutdownTimeout time.Duration
+	Health          *health.Registry
+	// Metrics - необязательный реестр метрик HTTP-запросов и /metrics
+	Metrics *metrics.Registry
+	// Events - источник событий для потоков SSE
+	Events EventSubscriber
+	// HeartbeatInterval - период пульса потоков SSE; 0 - значение по умолчанию
+	HeartbeatInterval time.Duration
 }
 
-func NewServer(log *slog.Logger, service QNADispatcher, addr *string) *Server {
-	restServer := NewRestServer(&serverAPI{addr: addr, log: log, service: service})
+func NewServer(deps ServerDeps) *Server {
+	ready := &atomic.Bool{}
+	deps.Health.RegisterReadiness("http_server", health.CheckerFunc(func(ctx context.Context) error {
+		if !ready.Load() {
+			return errors.New("server is not accepting traffic")
+		}
+		return nil
+	}))
+
+	restServer := NewRestServer(&serverAPI{
+		addr:      deps.Addr,
+		log:       deps.Log,
+		service:   deps.Service,
+		auth:      deps.Auth,
+		tokens:    deps.Tokens,
+		health:    deps.Health,
+		metrics:   deps.Metrics,
+		events:    deps.Events,
+		heartbeat: deps.HeartbeatInterval,
+		shutdown:  make(chan struct{}),
+	})
 	return &Server{
-		log:        log,
-		restServer: restServer,
-		service:    service,
-		addr:       addr,
+		log:             deps.Log,
+		restServer:      restServer,
+		service:         deps.Service,
+		addr:            deps.Addr,
+		ready:           ready,
+		shutdownTimeout: deps.ShutdownTimeout,
 	}
 }
 
 func NewRestServer(api *serverAPI) *http.Server {
-	mux := http.NewServeMux()
-
-	mux.HandleFunc("POST /users/", api.CreateUser)
-	mux.HandleFunc("GET /users/", api.GetUsers)
-	mux.HandleFunc("DELETE /users/{id}", api.DeleteUser)
-	mux.HandleFunc("GET /questions/{id}", api.GetQuestionAndAnswers)
-	mux.HandleFunc("DELETE /questions/{id}", api.DeleteQuestionAndAnswers)
-	mux.HandleFunc("POST /questions/{id}/answers/", api.CreateAnswerToQuestion)
-	mux.HandleFunc("GET /questions/", api.GetQuestions)
-	mux.HandleFunc("POST /questions/", api.CreateQuestion)
-	mux.HandleFunc("GET /answers/{id}", api.GetAnswer)
-	mux.HandleFunc("DELETE /answers/{id}", api.DeleteAnswer)
+	mux := api.routes()
 
 	var handler http.Handler = mux
+	if api.metrics != nil {
+		handler = middleware.MetricsMiddleware(api.metrics, handler)
+	}
 	handler = middleware.CORSMiddleware(handler)
 	handler = middleware.LoggMiddleware(api.log, handler)
+	handler = middleware.TracingMiddleware(handler)
 
 	server := &http.Server{
 		Addr:         *api.addr,
@@ -79,22 +157,142 @@ func NewRestServer(api *serverAPI) *http.Server {
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
+	// Shutdown не прерывает активные соединения, поэтому потоки событий
+	// завершаются сами по сигналу
+	server.RegisterOnShutdown(func() {
+		close(api.shutdown)
+	})
 
 	return server
 }
 
+// router - ServeMux, запоминающий шаблоны зарегистрированных маршрутов.
+type router struct {
+	*http.ServeMux
+	patterns []string
+}
+
+func (t *router) Handle(pattern string, handler http.Handler) {
+	t.ServeMux.Handle(pattern, handler)
+	t.patterns = append(t.patterns, pattern)
+}
+
+func (t *router) HandleFunc(pattern string, handler http.HandlerFunc) {
+	t.Handle(pattern, handler)
+}
+
+// routes регистрирует маршруты API; каждый из них описан в openapi/openapi.json.
+func (t *serverAPI) routes() *router {
+	mux := &router{ServeMux: http.NewServeMux()}
+
+	mux.HandleFunc("POST /users/", t.CreateUser)
+	mux.HandleFunc("GET /users/", t.GetUsers)
+	mux.Handle("PATCH /users/{id}", t.authenticated(t.PatchUser))
+	mux.Handle("DELETE /users/{id}", t.authenticated(t.DeleteUser))
+	mux.Handle("POST /users/{id}/restore", t.authenticated(t.RestoreUser))
+	mux.HandleFunc("GET /questions/{id}", t.GetQuestionAndAnswers)
+	mux.Handle("PATCH /questions/{id}", t.authenticated(t.PatchQuestion))
+	mux.Handle("DELETE /questions/{id}", t.authenticated(t.DeleteQuestionAndAnswers))
+	mux.Handle("POST /questions/{id}/restore", t.authenticated(t.RestoreQuestion))
+	mux.HandleFunc("GET /questions/{id}/events", t.GetQuestionEvents)
+	mux.HandleFunc("GET /questions/{id}/revisions", t.GetQuestionRevisions)
+	mux.Handle("PUT /questions/{id}/vote", t.authenticated(t.VoteQuestion))
+	mux.Handle("DELETE /questions/{id}/vote", t.authenticated(t.RetractQuestionVote))
+	mux.Handle("POST /questions/{id}/accept/{answerId}", t.authenticated(t.AcceptAnswer))
+	mux.Handle("PUT /questions/{id}/status", t.authenticated(t.ChangeQuestionStatus))
+	mux.Handle("POST /questions/{id}/comments/", t.authenticated(t.CreateQuestionComment))
+	mux.HandleFunc("GET /questions/{id}/comments/", t.GetQuestionComments)
+	mux.Handle("DELETE /questions/{id}/comments/{commentId}", t.authenticated(t.DeleteQuestionComment))
+	mux.Handle("POST /questions/{id}/answers/", t.authenticated(t.CreateAnswerToQuestion))
+	mux.HandleFunc("GET /questions/", t.GetQuestions)
+	mux.Handle("POST /questions/", t.authenticated(t.CreateQuestion))
+	mux.HandleFunc("GET /answers/{id}", t.GetAnswer)
+	mux.Handle("PATCH /answers/{id}", t.authenticated(t.PatchAnswer))
+	mux.Handle("DELETE /answers/{id}", t.authenticated(t.DeleteAnswer))
+	mux.Handle("POST /answers/{id}/restore", t.authenticated(t.RestoreAnswer))
+	mux.HandleFunc("GET /answers/{id}/revisions", t.GetAnswerRevisions)
+	mux.Handle("PUT /answers/{id}/vote", t.authenticated(t.VoteAnswer))
+	mux.Handle("DELETE /answers/{id}/vote", t.authenticated(t.RetractAnswerVote))
+	mux.Handle("POST /answers/{id}/comments/", t.authenticated(t.CreateAnswerComment))
+	mux.HandleFunc("GET /answers/{id}/comments/", t.GetAnswerComments)
+	mux.Handle("DELETE /answers/{id}/comments/{commentId}", t.authenticated(t.DeleteAnswerComment))
+	mux.HandleFunc("POST /auth/login", t.Login)
+	mux.HandleFunc("POST /auth/refresh", t.Refresh)
+	mux.HandleFunc("POST /auth/logout", t.Logout)
+	mux.HandleFunc("GET /tags/", t.GetTags)
+	mux.HandleFunc("GET /tags/{name}/questions", t.GetTagQuestions)
+	mux.HandleFunc("GET /search", t.Search)
+	mux.HandleFunc("GET /events", t.GetEvents)
+	mux.Handle("GET /trash/", t.authenticated(t.GetTrash))
+	mux.Handle("POST /webhooks/", t.authenticated(t.CreateWebhook))
+	mux.Handle("DELETE /webhooks/{id}", t.authenticated(t.DeleteWebhook))
+	mux.Handle("GET /webhooks/{id}/deliveries", t.authenticated(t.GetWebhookDeliveries))
+	mux.HandleFunc("GET /livez", t.Live)
+	mux.HandleFunc("GET /readyz", t.Ready)
+	mux.HandleFunc("GET /startupz", t.Startup)
+	mux.HandleFunc("GET /openapi.json", t.OpenAPI)
+	mux.HandleFunc("GET /docs", t.Docs)
+	if t.metrics != nil {
+		mux.Handle("GET /metrics", t.metrics.Handler())
+	}
+
+	return mux
+}
+
+// authenticated пропускает к обработчику только запросы с действительным access-токеном.
+func (t *serverAPI) authenticated(handler http.HandlerFunc) http.Handler {
+	return middleware.AuthMiddleware(t.tokens, handler)
+}
+
 func (t *Server) Run() error {
 	const op = "internal/infrastructure/rest/handler.Server.Run"
 	log := t.log.With(slog.String("operation", op), slog.String("addr", *t.addr))
 	log.Info("server is running")
 
-	if err := t.restServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	listener, err := net.Listen("tcp", t.restServer.Addr)
+	if err != nil {
+		return errors.Wrap(err, strings.Join([]string{op, "failed to listen"}, ": "))
+	}
+
+	// сервер готов принимать трафик только после открытия порта
+	t.ready.Store(true)
+	if err := t.restServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		t.ready.Store(false)
 		return errors.Wrap(err, strings.Join([]string{op, "failed to serve rest server"}, ": "))
 	}
 
 	return nil
 }
 
+// Handler возвращает обработчик HTTP API со всеми промежуточными слоями.
+func (t *Server) Handler() http.Handler {
+	return t.restServer.Handler
+}
+
+// SetReady переключает готовность сервера принимать трафик от балансировщика.
+func (t *Server) SetReady(ready bool) {
+	t.ready.Store(ready)
+}
+
+// Shutdown перестает принимать новые соединения и ожидает завершения текущих
+// запросов, но не дольше shutdownTimeout.
+func (t *Server) Shutdown(ctx context.Context) error {
+	const op = "internal/infrastructure/rest/handler.Server.Shutdown"
+	log := t.log.With(slog.String("operation", op), slog.String("addr", *t.addr))
+
+	t.ready.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, t.shutdownTimeout)
+	defer cancel()
+
+	if err := t.restServer.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, strings.Join([]string{op, "failed to shutdown rest server"}, ": "))
+	}
+
+	log.Info("server is stopped")
+	return nil
+}
+
 func (t *serverAPI) CreateUser(w http.ResponseWriter, r *http.Request) {
 	var errorList []error
 	ctx := r.Context()
@@ -132,20 +330,34 @@ func (t *serverAPI) CreateUser(w http.ResponseWriter, r *http.Request) {
 		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
 		return
 	}
+	if len(req.Password) == 0 {
+		errorList = append(errorList, errors.New("field \"password\" must not be empty"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "field \"password\" must not be empty"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
 
 	// Вызов метода сервиса
-	userId, err := t.service.CreateUser(ctx, &req.Name)
+	userId, err := t.service.CreateUser(ctx, &req.Name, &req.Password)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
@@ -165,27 +377,46 @@ func (t *serverAPI) GetUsers(w http.ResponseWriter, r *http.Request) {
 	var errorList []error
 	ctx := r.Context()
 
+	// Валидация входных данных
+	params, err := parseListParams(r)
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, err.Error()),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
 	// Вызов метода сервиса
-	users, err := t.service.GetUsers(ctx)
+	users, page, err := t.service.GetUsers(ctx, params)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
 	// Формирование ответа
+	meta := setNextPageLink(w, r, params, page)
 	err = response.ReturnResponse(
 		w,
 		http.StatusOK,
 		response.WithData(*users),
+		response.WithMeta(meta),
 	)
 	if err != nil {
 		errorList = append(errorList, err)
@@ -219,15 +450,16 @@ func (t *serverAPI) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	err = t.service.DeleteUser(ctx, &userId)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
@@ -246,27 +478,54 @@ func (t *serverAPI) GetQuestions(w http.ResponseWriter, r *http.Request) {
 	var errorList []error
 	ctx := r.Context()
 
+	// Валидация входных данных
+	params, err := parseListParams(r)
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, err.Error()),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	filter := domain.QuestionFilter{
+		Tags:     r.URL.Query()["tag"],
+		TagMatch: domain.TagMatch(r.URL.Query().Get("tag_match")),
+	}
+	for _, status := range r.URL.Query()["status"] {
+		filter.Statuses = append(filter.Statuses, domain.QuestionStatus(status))
+	}
+
 	// Вызов метода сервиса
-	questions, err := t.service.GetQuestions(ctx)
+	questions, page, err := t.service.GetQuestions(ctx, params, &filter)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
 	// Формирование ответа
+	meta := setNextPageLink(w, r, params, page)
 	err = response.ReturnResponse(
 		w,
 		http.StatusOK,
 		response.WithData(*questions),
+		response.WithMeta(meta),
 	)
 	if err != nil {
 		errorList = append(errorList, err)
@@ -298,6 +557,32 @@ func (t *serverAPI) CreateQuestion(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Валидация входных данных
+	if len(strings.TrimSpace(req.Title)) == 0 {
+		errorList = append(errorList, errors.New("field \"title\" must not be empty"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "field \"title\" must not be empty"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+	if utf8.RuneCountInString(req.Title) > domain.MaxQuestionTitleLength {
+		errorList = append(errorList, errors.New("field \"title\" must not exceed 200 characters"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "field \"title\" must not exceed 200 characters"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
 	if len(req.Text) == 0 {
 		errorList = append(errorList, errors.New("field \"text\" must not be empty"))
 		err := response.ReturnResponse(
@@ -313,18 +598,24 @@ func (t *serverAPI) CreateQuestion(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Вызов метода сервиса
-	questionId, err := t.service.CreateQuestion(ctx, &req.Text)
+	question := domain.Question{
+		Title: req.Title,
+		Text:  req.Text,
+		Tags:  req.Tags,
+	}
+	questionId, err := t.service.CreateQuestion(ctx, &question)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
@@ -374,28 +665,62 @@ func (t *serverAPI) GetQuestionAndAnswers(w http.ResponseWriter, r *http.Request
 		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
 		return
 	}
+	include := r.URL.Query().Get("include")
+	if include != "" && include != includeComments {
+		errorList = append(errorList, errors.New("\"include\" must be one of: comments"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "\"include\" must be one of: comments"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
 
 	// Вызов метода сервиса
 	question, answers, err := t.service.GetQuestionAndAnswers(ctx, questionIdInt)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
+	resp := response.GetQuestionAndAnswersResponse{Question: *question, Answers: *answers}
+	if include == includeComments {
+		resp.Comments, err = t.service.GetThreadComments(ctx, questionIdInt)
+		if err != nil {
+			errorList = append(errorList, err)
+			status, code, desc := serviceError(err)
+			err := response.ReturnResponse(
+				w,
+				status,
+				response.WithError(code, desc),
+			)
+			if err != nil {
+				errorList = append(errorList, err)
+			}
+			middleware.UpdateContext(ctx, r, status, &errorList)
+			return
+		}
+	}
+
 	// Формирование ответа
 	err = response.ReturnResponse(
 		w,
 		http.StatusOK,
-		response.WithData(response.GetQuestionAndAnswersResponse{Question: *question, Answers: *answers}),
+		response.WithData(resp),
 	)
 	if err != nil {
 		errorList = append(errorList, err)
@@ -442,15 +767,16 @@ func (t *serverAPI) DeleteQuestionAndAnswers(w http.ResponseWriter, r *http.Requ
 	err = t.service.DeleteQuestionAndAnswers(ctx, questionIdInt)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
@@ -530,7 +856,10 @@ func (t *serverAPI) CreateAnswerToQuestion(w http.ResponseWriter, r *http.Reques
 		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
 		return
 	}
-	_, err = uuid.Parse(req.UserId)
+	// автор ответа определяется по access-токену, "user_id" необязателен
+	if req.UserId != "" {
+		_, err = uuid.Parse(req.UserId)
+	}
 	if err != nil {
 		errorList = append(errorList, err)
 		err := response.ReturnResponse(
@@ -554,15 +883,16 @@ func (t *serverAPI) CreateAnswerToQuestion(w http.ResponseWriter, r *http.Reques
 	answerId, err := t.service.CreateAnswerToQuestion(ctx, &answer)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
@@ -617,15 +947,16 @@ func (t *serverAPI) GetAnswer(w http.ResponseWriter, r *http.Request) {
 	answer, err := t.service.GetAnswer(ctx, answerIdInt)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
@@ -680,15 +1011,16 @@ func (t *serverAPI) DeleteAnswer(w http.ResponseWriter, r *http.Request) {
 	err = t.service.DeleteAnswer(ctx, answerIdInt)
 	if err != nil {
 		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
 		err := response.ReturnResponse(
 			w,
-			http.StatusInternalServerError,
-			response.WithError(response.ErrCodeInternalServerError, "internal server error"),
+			status,
+			response.WithError(code, desc),
 		)
 		if err != nil {
 			errorList = append(errorList, err)
 		}
-		middleware.UpdateContext(ctx, r, http.StatusInternalServerError, &errorList)
+		middleware.UpdateContext(ctx, r, status, &errorList)
 		return
 	}
 
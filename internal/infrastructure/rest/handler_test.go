@@ -1,22 +1,42 @@
 package rest
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	gotoken "go/token"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/health"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
 	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/mocks"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/token"
+	"github.com/Vy4cheSlave/qna/internal/metrics"
+	"github.com/Vy4cheSlave/qna/internal/usecase"
 )
 
 type testCase struct {
@@ -131,6 +151,29 @@ func TestCreateAnswerToQuestion(t *testing.T) {
 				"status": http.StatusText(http.StatusInternalServerError),
 			},
 		},
+		{
+			name:        "question or user not found",
+			requestBody: `{"user_id": "f47ac10b-58cc-4372-a567-0e02b2c3de91", "text": "text"}`,
+			requestPath: "1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("CreateAnswerToQuestion",
+					mock.Anything,
+					&domain.Answer{
+						UserId:     "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+						QuestionId: 1,
+						Text:       "text",
+					},
+				).Return(0, errors.Wrap(domain.ErrReferenceNotFound, "op")).Once()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeReferenceNotFound,
+					"desc": "referenced resource not found",
+				},
+				"status": http.StatusText(http.StatusUnprocessableEntity),
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -171,3 +214,1683 @@ func TestCreateAnswerToQuestion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAnswer(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestPath: "1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetAnswer", mock.Anything, 1).Return(&domain.Answer{
+					Id:         1,
+					QuestionId: 1,
+					UserId:     "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+					Text:       "text",
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"Id":         float64(1),
+					"QuestionId": float64(1),
+					"UserId":     "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+					"Text":       "text",
+					"Score":      float64(0),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:        "not found",
+			requestPath: "2",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetAnswer", mock.Anything, 2).
+					Return(nil, errors.Wrap(fmt.Errorf("%w: record not found", domain.ErrNotFound), "op")).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeNotFound,
+					"desc": "resource not found",
+				},
+				"status": http.StatusText(http.StatusNotFound),
+			},
+		},
+		{
+			name:        "domain error description",
+			requestPath: "3",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetAnswer", mock.Anything, 3).
+					Return(nil, errors.Wrap(domain.NewError(domain.ErrForbidden, "answer is hidden"), "op")).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeForbidden,
+					"desc": "answer is hidden",
+				},
+				"status": http.StatusText(http.StatusForbidden),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/answers/%s", tt.requestPath), nil)
+			req.SetPathValue("id", tt.requestPath)
+
+			w := httptest.NewRecorder()
+			handler.GetAnswer(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetQuestions(t *testing.T) {
+	nextCursor := &domain.Cursor{CreatedAt: time.Date(2025, 11, 13, 19, 0, 0, 0, time.UTC), Id: "21"}
+
+	testCases := []struct {
+		testCase
+		expectedLink string
+	}{
+		{
+			testCase: testCase{
+				name:        "Success with next page",
+				requestPath: "?limit=1&order=asc&status=open&tag=go&tag_match=any",
+				setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+					mockDispatcher.On("GetQuestions", mock.Anything, &domain.ListParams{
+						Limit: 1,
+						Order: domain.SortAsc,
+					}, &domain.QuestionFilter{
+						Statuses: []domain.QuestionStatus{domain.QuestionStatusOpen},
+						Tags:     []string{"go"},
+						TagMatch: domain.TagMatchAny,
+					}).Return(&[]domain.Question{{
+						Id:        20,
+						UserId:    "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+						Title:     "title",
+						Text:      "text",
+						Tags:      []string{"go"},
+						Status:    domain.QuestionStatusOpen,
+						CreatedAt: nextCursor.CreatedAt,
+					}}, &domain.Page{NextCursor: nextCursor}, nil).Once()
+				},
+				expectedStatus: http.StatusOK,
+				expectedResp: map[string]interface{}{
+					"data": []interface{}{
+						map[string]interface{}{
+							"Id":               float64(20),
+							"UserId":           "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+							"Title":            "title",
+							"Text":             "text",
+							"Tags":             []interface{}{"go"},
+							"Score":            float64(0),
+							"Status":           "open",
+							"CreatedAt":        "2025-11-13T19:00:00Z",
+							"AcceptedAnswerId": nil,
+						},
+					},
+					"meta": map[string]interface{}{
+						"next_cursor": nextCursor.Encode(),
+						"limit":       float64(1),
+					},
+					"status": http.StatusText(http.StatusOK),
+				},
+			},
+			expectedLink: fmt.Sprintf("</questions/?cursor=%s&limit=1&order=asc&status=open&tag=go&tag_match=any>; rel=\"next\"", nextCursor.Encode()),
+		},
+		{
+			testCase: testCase{
+				name:        "Last page",
+				requestPath: "?cursor=" + nextCursor.Encode(),
+				setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+					mockDispatcher.On("GetQuestions", mock.Anything, &domain.ListParams{
+						Limit: domain.DefaultPageLimit,
+						Order: domain.SortDesc,
+						After: nextCursor,
+					}, &domain.QuestionFilter{}).Return(&[]domain.Question{}, &domain.Page{}, nil).Once()
+				},
+				expectedStatus: http.StatusOK,
+				expectedResp: map[string]interface{}{
+					"data": []interface{}{},
+					"meta": map[string]interface{}{
+						"limit": float64(domain.DefaultPageLimit),
+					},
+					"status": http.StatusText(http.StatusOK),
+				},
+			},
+		},
+		{
+			testCase: testCase{
+				name:           "invalid limit",
+				requestPath:    "?limit=1000",
+				setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+				expectedStatus: http.StatusBadRequest,
+				expectedResp: map[string]interface{}{
+					"error": map[string]interface{}{
+						"code": response.ErrCodeValidationFailed,
+						"desc": "\"limit\" must be an integer between 1 and 100",
+					},
+					"status": http.StatusText(http.StatusBadRequest),
+				},
+			},
+		},
+		{
+			testCase: testCase{
+				name:           "invalid cursor",
+				requestPath:    "?cursor=garbage",
+				setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+				expectedStatus: http.StatusBadRequest,
+				expectedResp: map[string]interface{}{
+					"error": map[string]interface{}{
+						"code": response.ErrCodeValidationFailed,
+						"desc": "invalid \"cursor\"",
+					},
+					"status": http.StatusText(http.StatusBadRequest),
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/questions/"+tt.requestPath, nil)
+
+			w := httptest.NewRecorder()
+			handler.GetQuestions(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedLink, resp.Header.Get("Link"))
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetTags(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestPath: "?limit=2&offset=1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetTags", mock.Anything, &domain.TagQuery{Limit: 2, Offset: 1}).
+					Return(&[]domain.Tag{{Name: "go", QuestionCount: 3}, {Name: "sql", QuestionCount: 1}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": []interface{}{
+					map[string]interface{}{"Name": "go", "QuestionCount": float64(3)},
+					map[string]interface{}{"Name": "sql", "QuestionCount": float64(1)},
+				},
+				"meta": map[string]interface{}{
+					"limit": float64(2),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "invalid offset",
+			requestPath:    "?offset=-1",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "\"offset\" must be a non-negative integer",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/tags/"+tt.requestPath, nil)
+
+			w := httptest.NewRecorder()
+			handler.GetTags(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetTagQuestions(t *testing.T) {
+	createdAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestPath: "Go",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetTagQuestions", mock.Anything, "Go", &domain.ListParams{
+					Limit: domain.DefaultPageLimit,
+					Order: domain.SortDesc,
+				}).Return(&[]domain.Question{{
+					Id:        1,
+					UserId:    "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+					Title:     "title",
+					Text:      "text",
+					Tags:      []string{"go"},
+					Status:    domain.QuestionStatusOpen,
+					CreatedAt: createdAt,
+				}}, &domain.Page{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": []interface{}{
+					map[string]interface{}{
+						"Id":               float64(1),
+						"UserId":           "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+						"Title":            "title",
+						"Text":             "text",
+						"Tags":             []interface{}{"go"},
+						"Score":            float64(0),
+						"Status":           "open",
+						"CreatedAt":        "2026-10-17T12:00:00Z",
+						"AcceptedAnswerId": nil,
+					},
+				},
+				"meta": map[string]interface{}{
+					"limit": float64(domain.DefaultPageLimit),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:        "Invalid tag",
+			requestPath: "go%2Flang",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetTagQuestions", mock.Anything, "go/lang", mock.Anything).
+					Return(nil, nil, domain.NewError(domain.ErrValidation, "tag \"go/lang\" contains invalid characters")).Once()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "tag \"go/lang\" contains invalid characters",
+				},
+				"status": http.StatusText(http.StatusUnprocessableEntity),
+			},
+		},
+		{
+			name:        "Unknown tag",
+			requestPath: "rust",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetTagQuestions", mock.Anything, "rust", mock.Anything).
+					Return(nil, nil, domain.ErrNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeNotFound,
+					"desc": "resource not found",
+				},
+				"status": http.StatusText(http.StatusNotFound),
+			},
+		},
+		{
+			name:           "invalid order",
+			requestPath:    "go?order=up",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "\"order\" must be one of: asc, desc",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/tags/"+tt.requestPath, nil)
+			req.SetPathValue("name", strings.TrimPrefix(req.URL.Path, "/tags/"))
+
+			w := httptest.NewRecorder()
+			handler.GetTagQuestions(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestPath: "?q=goroutine&lang=english&limit=5",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("Search", mock.Anything, &domain.SearchQuery{
+					Text:     "goroutine",
+					Language: "english",
+					Limit:    5,
+				}).Return(&[]domain.SearchHit{{
+					Kind:       domain.SearchKindAnswer,
+					Id:         3,
+					QuestionId: 1,
+					Rank:       0.5,
+					Snippet:    "<mark>goroutine</mark> leak",
+				}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": []interface{}{
+					map[string]interface{}{
+						"Kind":       domain.SearchKindAnswer,
+						"Id":         float64(3),
+						"QuestionId": float64(1),
+						"Rank":       0.5,
+						"Snippet":    "<mark>goroutine</mark> leak",
+					},
+				},
+				"meta": map[string]interface{}{
+					"limit": float64(5),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "empty query",
+			requestPath:    "?q=",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "query parameter \"q\" must not be empty",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:        "unsupported language",
+			requestPath: "?q=text&lang=klingon",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("Search", mock.Anything, mock.Anything).
+					Return(nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unsupported search language"), "op")).Once()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "unsupported search language",
+				},
+				"status": http.StatusText(http.StatusUnprocessableEntity),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/search"+tt.requestPath, nil)
+
+			w := httptest.NewRecorder()
+			handler.Search(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		requestBody    string
+		setupMock      func(*mocks.MockAuthDispatcher)
+		expectedStatus int
+		expectedError  map[string]interface{}
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"name": "user", "password": "password"}`,
+			setupMock: func(mockAuth *mocks.MockAuthDispatcher) {
+				name, password := "user", "password"
+				mockAuth.On("Login", mock.Anything, &name, &password).Return(&domain.TokenPair{
+					AccessToken:           "access",
+					AccessTokenExpiresAt:  time.Now().Add(time.Minute),
+					RefreshToken:          "refresh",
+					RefreshTokenExpiresAt: expiresAt,
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "empty password",
+			requestBody:    `{"name": "user", "password": ""}`,
+			setupMock:      func(mockAuth *mocks.MockAuthDispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: map[string]interface{}{
+				"code": response.ErrCodeValidationFailed,
+				"desc": "fields \"name\" and \"password\" must not be empty",
+			},
+		},
+		{
+			name:        "invalid credentials",
+			requestBody: `{"name": "user", "password": "wrong"}`,
+			setupMock: func(mockAuth *mocks.MockAuthDispatcher) {
+				mockAuth.On("Login", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "invalid name or password"), "op")).Once()
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: map[string]interface{}{
+				"code": response.ErrCodeUnauthorized,
+				"desc": "invalid name or password",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthDispatcher := mocks.NewMockAuthDispatcher(t)
+			tt.setupMock(mockAuthDispatcher)
+
+			handler := &serverAPI{
+				auth: mockAuthDispatcher,
+				log:  slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.Login(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, responseBody["error"])
+			} else {
+				data := responseBody["data"].(map[string]interface{})
+				assert.Equal(t, "access", data["access_token"])
+				assert.Equal(t, "Bearer", data["token_type"])
+				assert.Equal(t, "refresh", data["refresh_token"])
+				assert.Equal(t, expiresAt.Format(time.RFC3339), data["refresh_token_expires_at"])
+			}
+
+			mockAuthDispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	tokens := token.NewManager([]byte("secret"), time.Minute)
+	userId := "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+	accessToken, _, err := tokens.IssueAccessToken(&domain.Principal{UserId: userId})
+	require.NoError(t, err)
+	expiredToken, _, err := token.NewManager([]byte("secret"), -time.Minute).IssueAccessToken(&domain.Principal{UserId: userId})
+	require.NoError(t, err)
+	foreignToken, _, err := token.NewManager([]byte("other"), time.Minute).IssueAccessToken(&domain.Principal{UserId: userId})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		header         string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "Success", header: "Bearer " + accessToken, expectedStatus: http.StatusOK},
+		{name: "missing header", expectedStatus: http.StatusUnauthorized, expectedCode: response.ErrCodeMissingAuthHeader},
+		{name: "wrong scheme", header: "Basic " + accessToken, expectedStatus: http.StatusUnauthorized, expectedCode: response.ErrCodeInvalidToken},
+		{name: "expired token", header: "Bearer " + expiredToken, expectedStatus: http.StatusUnauthorized, expectedCode: response.ErrCodeInvalidToken},
+		{name: "foreign signature", header: "Bearer " + foreignToken, expectedStatus: http.StatusUnauthorized, expectedCode: response.ErrCodeInvalidToken},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := middleware.AuthMiddleware(tokens, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal, ok := domain.PrincipalFromContext(r.Context())
+				require.True(t, ok)
+				assert.Equal(t, userId, principal.UserId)
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodDelete, "/answers/1", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedCode != "" {
+				var responseBody map[string]interface{}
+				err := json.NewDecoder(resp.Body).Decode(&responseBody)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCode, responseBody["error"].(map[string]interface{})["code"])
+			}
+		})
+	}
+}
+
+func TestCreateQuestion(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestBody: `{"title": "title", "text": "text"}`,
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("CreateQuestion", mock.Anything, &domain.Question{
+					Title: "title",
+					Text:  "text",
+				}).Return(1, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"question_id": float64(1),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "field \"title\" must not be empty",
+			requestBody:    `{"title": "  ", "text": "text"}`,
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"title\" must not be empty",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:           "field \"title\" is too long",
+			requestBody:    fmt.Sprintf(`{"title": "%s", "text": "text"}`, strings.Repeat("я", domain.MaxQuestionTitleLength+1)),
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"title\" must not exceed 200 characters",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:           "field \"text\" must not be empty",
+			requestBody:    `{"title": "title", "text": ""}`,
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"text\" must not be empty",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/questions/", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.CreateQuestion(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPatchQuestion(t *testing.T) {
+	createdAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	text := "new text"
+
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestBody: `{"text": "new text"}`,
+			requestPath: "1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("UpdateQuestion", mock.Anything, 1, &domain.QuestionPatch{Text: &text}).
+					Return(&domain.Question{
+						Id:        1,
+						UserId:    "3f1c6f5e-2b1a-4c59-9d7e-1a2b3c4d5e6f",
+						Title:     "title",
+						Text:      "new text",
+						Tags:      []string{"go"},
+						Status:    domain.QuestionStatusOpen,
+						CreatedAt: createdAt,
+					}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"Id":               float64(1),
+					"UserId":           "3f1c6f5e-2b1a-4c59-9d7e-1a2b3c4d5e6f",
+					"Title":            "title",
+					"Text":             "new text",
+					"Tags":             []interface{}{"go"},
+					"Score":            float64(0),
+					"Status":           "open",
+					"CreatedAt":        "2026-10-17T12:00:00Z",
+					"AcceptedAnswerId": nil,
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "field \"title\" must not be null",
+			requestBody:    `{"title": null}`,
+			requestPath:    "1",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"title\" must not be null",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:           "Empty patch",
+			requestBody:    `{}`,
+			requestPath:    "1",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "patch must contain \"title\", \"text\" or \"tags\"",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:        "Not the author",
+			requestBody: `{"text": "new text"}`,
+			requestPath: "1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("UpdateQuestion", mock.Anything, 1, &domain.QuestionPatch{Text: &text}).
+					Return(nil, domain.ErrForbidden).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeForbidden,
+					"desc": "forbidden",
+				},
+				"status": http.StatusText(http.StatusForbidden),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/questions/%s", tt.requestPath), bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			req.SetPathValue("id", tt.requestPath)
+
+			w := httptest.NewRecorder()
+			handler.PatchQuestion(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	userId := "3f1c6f5e-2b1a-4c59-9d7e-1a2b3c4d5e6f"
+	password := "new password"
+
+	testCases := []testCase{
+		{
+			name:        "Set password",
+			requestBody: `{"password": "new password"}`,
+			requestPath: userId,
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("UpdateUser", mock.Anything, &userId, &domain.UserPatch{Password: &password}).
+					Return(&domain.User{Id: userId, Name: "alice", Role: domain.RoleUser}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"Id":   userId,
+					"Name": "alice",
+					"Role": "user",
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "field \"password\" must not be null",
+			requestBody:    `{"password": null}`,
+			requestPath:    userId,
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"password\" must not be null",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:           "Empty patch",
+			requestBody:    `{}`,
+			requestPath:    userId,
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "patch must contain \"name\" or \"password\"",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:        "Password too short",
+			requestBody: `{"password": "new password"}`,
+			requestPath: userId,
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("UpdateUser", mock.Anything, &userId, &domain.UserPatch{Password: &password}).
+					Return(nil, domain.NewError(domain.ErrValidation, "password length must be between 8 and 72 bytes")).Once()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "password length must be between 8 and 72 bytes",
+				},
+				"status": http.StatusText(http.StatusUnprocessableEntity),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/users/%s", tt.requestPath), bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			req.SetPathValue("id", tt.requestPath)
+
+			w := httptest.NewRecorder()
+			handler.PatchUser(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPatchUnsupportedMediaType(t *testing.T) {
+	handler := &serverAPI{
+		service: mocks.NewMockQNADispatcher(t),
+		log:     slog.Default(),
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/answers/1", bytes.NewBufferString(`text=new`))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetPathValue("id", "1")
+
+	w := httptest.NewRecorder()
+	handler.PatchAnswer(w, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func TestVoteAnswer(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestBody: `{"value": -1}`,
+			requestPath: "1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				vote := domain.VoteDown
+				mockDispatcher.On("VoteAnswer", mock.Anything, 1, domain.VoteDown).
+					Return(&domain.VoteResult{Score: 4, Vote: &vote}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"score": float64(4),
+					"vote":  float64(-1),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "field \"value\" must be 1 or -1",
+			requestBody:    `{"value": 2}`,
+			requestPath:    "1",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"value\" must be 1 or -1",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:        "Answer not found",
+			requestBody: `{"value": 1}`,
+			requestPath: "2",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("VoteAnswer", mock.Anything, 2, domain.VoteUp).
+					Return(nil, domain.ErrNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeNotFound,
+					"desc": "resource not found",
+				},
+				"status": http.StatusText(http.StatusNotFound),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/answers/%s/vote", tt.requestPath), bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("id", tt.requestPath)
+
+			w := httptest.NewRecorder()
+			handler.VoteAnswer(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetQuestionAndAnswersWithComments(t *testing.T) {
+	createdAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestPath: "1?include=comments",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetQuestionAndAnswers", mock.Anything, 1).
+					Return(&domain.Question{Id: 1, Tags: []string{}, Status: domain.QuestionStatusOpen, CreatedAt: createdAt}, &[]domain.Answer{}, nil).Once()
+				mockDispatcher.On("GetThreadComments", mock.Anything, 1).
+					Return(&[]domain.Comment{{
+						Id:        3,
+						Parent:    domain.CommentParentQuestion,
+						ParentId:  1,
+						UserId:    "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+						Text:      "can you post the log?",
+						CreatedAt: createdAt,
+					}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"question": map[string]interface{}{
+						"Id":               float64(1),
+						"UserId":           "",
+						"Title":            "",
+						"Text":             "",
+						"Score":            float64(0),
+						"Tags":             []interface{}{},
+						"Status":           "open",
+						"AcceptedAnswerId": nil,
+						"CreatedAt":        "2026-10-17T12:00:00Z",
+					},
+					"answers": []interface{}{},
+					"comments": []interface{}{
+						map[string]interface{}{
+							"Id":        float64(3),
+							"Parent":    "question",
+							"ParentId":  float64(1),
+							"UserId":    "f47ac10b-58cc-4372-a567-0e02b2c3de91",
+							"Text":      "can you post the log?",
+							"CreatedAt": "2026-10-17T12:00:00Z",
+						},
+					},
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "Unknown include",
+			requestPath:    "1?include=votes",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "\"include\" must be one of: comments",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/questions/%s", tt.requestPath), nil)
+			req.SetPathValue("id", "1")
+
+			w := httptest.NewRecorder()
+			handler.GetQuestionAndAnswers(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRestoreQuestion(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestPath: "1",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("RestoreQuestion", mock.Anything, 1).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "ID must be a positive integer",
+			requestPath:    "0",
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "ID must be a positive integer",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:        "Question author is deleted",
+			requestPath: "2",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("RestoreQuestion", mock.Anything, 2).
+					Return(domain.NewError(domain.ErrConflict, "question author is deleted")).Once()
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeConflict,
+					"desc": "question author is deleted",
+				},
+				"status": http.StatusText(http.StatusConflict),
+			},
+		},
+		{
+			name:        "Not enough permissions",
+			requestPath: "3",
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("RestoreQuestion", mock.Anything, 3).
+					Return(domain.NewError(domain.ErrForbidden, "not enough permissions")).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeForbidden,
+					"desc": "not enough permissions",
+				},
+				"status": http.StatusText(http.StatusForbidden),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/questions/%s/restore", tt.requestPath), nil)
+			req.SetPathValue("id", tt.requestPath)
+
+			w := httptest.NewRecorder()
+			handler.RestoreQuestion(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQuestionEvents(t *testing.T) {
+	broker := usecase.NewEventBroker(10)
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventAnswerCreated, QuestionId: 1, AnswerId: 1})
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventAnswerCreated, QuestionId: 2, AnswerId: 2})
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventAnswerCreated, QuestionId: 1, AnswerId: 3})
+
+	handler := &serverAPI{
+		addr:   nil,
+		log:    slog.Default(),
+		events: broker,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /questions/{id}/events", handler.GetQuestionEvents)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/questions/1/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "1")
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	// readEvent читает одно событие потока до пустой строки
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() []string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				return lines
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	// из буфера возвращается только пропущенное событие вопроса 1
+	replayed := readEvent()
+	require.Len(t, replayed, 3)
+	assert.Equal(t, "id: 3", replayed[0])
+	assert.Equal(t, "event: answer.created", replayed[1])
+	assert.Contains(t, replayed[2], `"question_id":1,"answer_id":3`)
+
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventQuestionDeleted, QuestionId: 2})
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventQuestionDeleted, QuestionId: 1})
+
+	live := readEvent()
+	require.Len(t, live, 3)
+	assert.Equal(t, "id: 5", live[0])
+	assert.Equal(t, "event: question.deleted", live[1])
+}
+
+func TestCreateWebhook(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:        "Success",
+			requestBody: `{"url": "https://bot.example.com/hooks", "secret": "0123456789abcdef", "events": ["answer.created"]}`,
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("CreateWebhook", mock.Anything, &domain.Webhook{
+					URL:    "https://bot.example.com/hooks",
+					Secret: "0123456789abcdef",
+					Events: []domain.EventType{domain.EventAnswerCreated},
+				}).Return(3, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"data": map[string]interface{}{
+					"webhook_id": float64(3),
+				},
+				"status": http.StatusText(http.StatusOK),
+			},
+		},
+		{
+			name:           "Empty url",
+			requestBody:    `{"secret": "0123456789abcdef"}`,
+			setupMock:      func(mockDispatcher *mocks.MockQNADispatcher) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "field \"url\" must not be empty",
+				},
+				"status": http.StatusText(http.StatusBadRequest),
+			},
+		},
+		{
+			name:        "Unknown event type",
+			requestBody: `{"url": "https://bot.example.com/hooks", "secret": "0123456789abcdef", "events": ["question.edited"]}`,
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("CreateWebhook", mock.Anything, mock.Anything).
+					Return(0, domain.NewError(domain.ErrValidation, "unknown event type")).Once()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeValidationFailed,
+					"desc": "unknown event type",
+				},
+				"status": http.StatusText(http.StatusUnprocessableEntity),
+			},
+		},
+		{
+			name:        "Not enough permissions",
+			requestBody: `{"url": "https://bot.example.com/hooks", "secret": "0123456789abcdef"}`,
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("CreateWebhook", mock.Anything, mock.Anything).
+					Return(0, domain.NewError(domain.ErrForbidden, "not enough permissions")).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResp: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": response.ErrCodeForbidden,
+					"desc": "not enough permissions",
+				},
+				"status": http.StatusText(http.StatusForbidden),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+			tt.setupMock(mockQNADispatcher)
+
+			handler := &serverAPI{
+				addr:    nil,
+				service: mockQNADispatcher,
+				log:     slog.Default(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/", bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.CreateWebhook(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var responseBody map[string]interface{}
+			err := json.NewDecoder(resp.Body).Decode(&responseBody)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedResp, responseBody)
+
+			mockQNADispatcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+	mockQNADispatcher.On("GetAnswer", mock.Anything, 7).
+		Return(nil, errors.Wrap(domain.ErrNotFound, "test")).Twice()
+
+	addr := ""
+	server := NewRestServer(&serverAPI{
+		addr:     &addr,
+		log:      slog.Default(),
+		service:  mockQNADispatcher,
+		metrics:  metrics.NewRegistry(),
+		shutdown: make(chan struct{}),
+	})
+
+	for _, path := range []string{"/answers/7", "/answers/7", "/no/such/path"} {
+		w := httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	w := httptest.NewRecorder()
+	server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+
+	// путь с идентификатором учитывается по шаблону маршрута
+	assert.Contains(t, body, `qna_http_requests_total{method="GET",route="/answers/{id}",status="404"} 2`)
+	assert.Contains(t, body, `qna_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, body, `qna_http_request_duration_seconds_count{method="GET",route="/answers/{id}",status="404"} 2`)
+	assert.NotContains(t, body, "/answers/7")
+
+	mockQNADispatcher.AssertExpectations(t)
+}
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	mockQNADispatcher := mocks.NewMockQNADispatcher(t)
+	mockQNADispatcher.On("GetAnswer", mock.Anything, 7).
+		Return(nil, errors.Wrap(domain.ErrNotFound, "test")).Once()
+
+	addr := ""
+	server := NewRestServer(&serverAPI{
+		addr:     &addr,
+		log:      slog.Default(),
+		service:  mockQNADispatcher,
+		shutdown: make(chan struct{}),
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/answers/7", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	server.Handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	// span запроса продолжает трассировку клиента и назван по шаблону маршрута
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /answers/{id}", span.Name())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Len(t, span.Events(), 1, "handler errors are recorded on the span")
+
+	mockQNADispatcher.AssertExpectations(t)
+}
+
+// openAPIDocument - часть документа OpenAPI, которую сверяет TestOpenAPISpec.
+type openAPIDocument struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+			Enum       []any                      `json:"enum"`
+		} `json:"schemas"`
+	} `json:"components"`
+}
+
+// jsonFields возвращает имена полей структуры в JSON с учетом тегов.
+func jsonFields(structType reflect.Type) []string {
+	var fields []string
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	var spec openAPIDocument
+	require.NoError(t, json.Unmarshal(openAPISpec, &spec))
+
+	mux := (&serverAPI{metrics: metrics.NewRegistry()}).routes()
+
+	t.Run("Routes", func(t *testing.T) {
+		var documented []string
+		for path, operations := range spec.Paths {
+			for method := range operations {
+				pattern := strings.ToUpper(method) + " " + path
+				documented = append(documented, pattern)
+
+				// документированный путь должен попадать именно в свой шаблон
+				target := regexp.MustCompile(`\{[^}]+\}`).ReplaceAllString(path, "1")
+				_, matched := mux.Handler(httptest.NewRequest(strings.ToUpper(method), target, nil))
+				assert.Equal(t, pattern, matched)
+			}
+		}
+		assert.ElementsMatch(t, mux.patterns, documented)
+	})
+
+	t.Run("Schemas", func(t *testing.T) {
+		types := map[string]reflect.Type{
+			"Response":                       reflect.TypeFor[response.Response](),
+			"Meta":                           reflect.TypeFor[response.Meta](),
+			"Error":                          reflect.TypeFor[response.Error](),
+			"CreateUserRequest":              reflect.TypeFor[request.CreateUserRequest](),
+			"CreateQuestionRequest":          reflect.TypeFor[request.CreateQuestionRequest](),
+			"CreateAnswerToQuestionRequest":  reflect.TypeFor[request.CreateAnswerToQuestionRequest](),
+			"LoginRequest":                   reflect.TypeFor[request.LoginRequest](),
+			"RefreshTokenRequest":            reflect.TypeFor[request.RefreshTokenRequest](),
+			"VoteRequest":                    reflect.TypeFor[request.VoteRequest](),
+			"ChangeQuestionStatusRequest":    reflect.TypeFor[request.ChangeQuestionStatusRequest](),
+			"CreateCommentRequest":           reflect.TypeFor[request.CreateCommentRequest](),
+			"CreateWebhookRequest":           reflect.TypeFor[request.CreateWebhookRequest](),
+			"PatchQuestionRequest":           reflect.TypeFor[request.PatchQuestionRequest](),
+			"PatchAnswerRequest":             reflect.TypeFor[request.PatchAnswerRequest](),
+			"PatchUserRequest":               reflect.TypeFor[request.PatchUserRequest](),
+			"CreateUserResponse":             reflect.TypeFor[response.CreateUserResponse](),
+			"CreateQuestionResponse":         reflect.TypeFor[response.CreateQuestionResponse](),
+			"GetQuestionAndAnswersResponse":  reflect.TypeFor[response.GetQuestionAndAnswersResponse](),
+			"CreateAnswerToQuestionResponse": reflect.TypeFor[response.CreateAnswerToQuestionResponse](),
+			"CreateCommentResponse":          reflect.TypeFor[response.CreateCommentResponse](),
+			"CreateWebhookResponse":          reflect.TypeFor[response.CreateWebhookResponse](),
+			"VoteResponse":                   reflect.TypeFor[response.VoteResponse](),
+			"TokenResponse":                  reflect.TypeFor[response.TokenResponse](),
+			"EventResponse":                  reflect.TypeFor[response.EventResponse](),
+			"User":                           reflect.TypeFor[domain.User](),
+			"Question":                       reflect.TypeFor[domain.Question](),
+			"Answer":                         reflect.TypeFor[domain.Answer](),
+			"Comment":                        reflect.TypeFor[domain.Comment](),
+			"Revision":                       reflect.TypeFor[domain.Revision](),
+			"Tag":                            reflect.TypeFor[domain.Tag](),
+			"TrashItem":                      reflect.TypeFor[domain.TrashItem](),
+			"SearchHit":                      reflect.TypeFor[domain.SearchHit](),
+			"WebhookDelivery":                reflect.TypeFor[domain.WebhookDelivery](),
+			"Report":                         reflect.TypeFor[health.Report](),
+			"CheckResult":                    reflect.TypeFor[health.CheckResult](),
+		}
+
+		for name, schema := range spec.Components.Schemas {
+			if schema.Properties == nil {
+				continue
+			}
+			structType, ok := types[name]
+			if !assert.True(t, ok, "schema %s is not bound to a Go type", name) {
+				continue
+			}
+			var properties []string
+			for property := range schema.Properties {
+				properties = append(properties, property)
+			}
+			assert.ElementsMatch(t, jsonFields(structType), properties, "schema %s", name)
+		}
+		for name := range types {
+			assert.Contains(t, spec.Components.Schemas, name)
+		}
+	})
+
+	t.Run("Error codes", func(t *testing.T) {
+		var codes []any
+		if schema, ok := spec.Components.Schemas["Error"]; ok {
+			var code struct {
+				Enum []any `json:"enum"`
+			}
+			require.NoError(t, json.Unmarshal(schema.Properties["code"], &code))
+			codes = code.Enum
+		}
+		assert.ElementsMatch(t, responseErrorCodes(t), codes)
+	})
+}
+
+// responseErrorCodes возвращает значения всех констант ErrCode* пакета response,
+// чтобы новый код ошибки нельзя было добавить, не описав его в спецификации.
+func responseErrorCodes(t *testing.T) []any {
+	t.Helper()
+
+	file, err := parser.ParseFile(gotoken.NewFileSet(), "dto/response/response.go", nil, 0)
+	require.NoError(t, err)
+
+	var codes []any
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != gotoken.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if !strings.HasPrefix(name.Name, "ErrCode") {
+					continue
+				}
+				literal, ok := valueSpec.Values[i].(*ast.BasicLit)
+				require.True(t, ok, "%s must be a string literal", name.Name)
+				code, err := strconv.Unquote(literal.Value)
+				require.NoError(t, err)
+				codes = append(codes, code)
+			}
+		}
+	}
+	require.NotEmpty(t, codes)
+	return codes
+}
+
+func TestOpenAPIEndpoints(t *testing.T) {
+	addr := ""
+	server := NewRestServer(&serverAPI{
+		addr:     &addr,
+		log:      slog.Default(),
+		shutdown: make(chan struct{}),
+	})
+
+	rec := httptest.NewRecorder()
+	server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, string(openAPISpec), rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "/openapi.json")
+}
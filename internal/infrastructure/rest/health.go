@@ -0,0 +1,42 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/health"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+)
+
+func (t *serverAPI) Live(w http.ResponseWriter, r *http.Request) {
+	t.writeHealthReport(w, r, t.health.Live(r.Context()))
+}
+
+func (t *serverAPI) Ready(w http.ResponseWriter, r *http.Request) {
+	t.writeHealthReport(w, r, t.health.Ready(r.Context()))
+}
+
+func (t *serverAPI) Startup(w http.ResponseWriter, r *http.Request) {
+	t.writeHealthReport(w, r, t.health.Startup(r.Context()))
+}
+
+func (t *serverAPI) writeHealthReport(w http.ResponseWriter, r *http.Request, report health.Report) {
+	var errorList []error
+	ctx := r.Context()
+
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+
+	// Формирование ответа
+	err := response.ReturnResponse(
+		w,
+		status,
+		response.WithData(report),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, status, &errorList)
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+type AccessTokenParser interface {
+	ParseAccessToken(accessToken string) (*domain.Principal, error)
+}
+
+// AuthMiddleware проверяет access-токен из заголовка Authorization и кладет
+// аутентифицированного пользователя в контекст запроса.
+func AuthMiddleware(parser AccessTokenParser, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var errorList []error
+		ctx := r.Context()
+
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			errorList = append(errorList, errors.New("missing Authorization header"))
+			err := response.ReturnResponse(
+				w,
+				http.StatusUnauthorized,
+				response.WithError(response.ErrCodeMissingAuthHeader, "missing Authorization header"),
+			)
+			if err != nil {
+				errorList = append(errorList, err)
+			}
+			UpdateContext(ctx, r, http.StatusUnauthorized, &errorList)
+			return
+		}
+
+		scheme, accessToken, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || accessToken == "" {
+			errorList = append(errorList, errors.New("invalid Authorization header format"))
+			err := response.ReturnResponse(
+				w,
+				http.StatusUnauthorized,
+				response.WithError(response.ErrCodeInvalidToken, "Authorization header must be \"Bearer <token>\""),
+			)
+			if err != nil {
+				errorList = append(errorList, err)
+			}
+			UpdateContext(ctx, r, http.StatusUnauthorized, &errorList)
+			return
+		}
+
+		principal, err := parser.ParseAccessToken(accessToken)
+		if err != nil {
+			errorList = append(errorList, err)
+			err := response.ReturnResponse(
+				w,
+				http.StatusUnauthorized,
+				response.WithError(response.ErrCodeInvalidToken, "invalid or expired access token"),
+			)
+			if err != nil {
+				errorList = append(errorList, err)
+			}
+			UpdateContext(ctx, r, http.StatusUnauthorized, &errorList)
+			return
+		}
+
+		*r = *r.WithContext(domain.ContextWithPrincipal(ctx, principal))
+		next.ServeHTTP(w, r)
+	}
+}
@@ -17,7 +17,7 @@ func LoggMiddleware(log *slog.Logger, next http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
-		log.Info(fmt.Sprintf("[START] %s %s | IP: %s",
+		log.InfoContext(r.Context(), fmt.Sprintf("[START] %s %s | IP: %s",
 			r.Method,
 			r.URL.Path,
 			r.RemoteAddr,
@@ -36,7 +36,7 @@ func LoggMiddleware(log *slog.Logger, next http.Handler) http.HandlerFunc {
 		}
 		errorList, _ := ctx.Value(CtxKeyErrorList).([]error)
 		if len(errorList) > 0 {
-			log.Error(fmt.Sprintf("[ERROR] %s %s | Status: %d | Duration: %v | ErrorList %v",
+			log.ErrorContext(ctx, fmt.Sprintf("[ERROR] %s %s | Status: %d | Duration: %v | ErrorList %v",
 				r.Method,
 				r.URL.Path,
 				statusDetails,
@@ -44,7 +44,7 @@ func LoggMiddleware(log *slog.Logger, next http.Handler) http.HandlerFunc {
 				errorList,
 			))
 		} else {
-			log.Info(fmt.Sprintf("[ END ] %s %s | Status: %d | Duration: %v",
+			log.InfoContext(ctx, fmt.Sprintf("[ END ] %s %s | Status: %d | Duration: %v",
 				r.Method,
 				r.URL.Path,
 				statusDetails,
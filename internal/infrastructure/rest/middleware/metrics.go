@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+type HTTPObserver interface {
+	ObserveHTTP(method string, route string, status int, duration time.Duration)
+}
+
+// MetricsMiddleware учитывает запросы по шаблону маршрута ServeMux (r.Pattern),
+// а не по пути, чтобы идентификаторы в пути не порождали новые временные ряды.
+// Должен оборачивать ServeMux: шаблон известен только после выбора обработчика.
+func MetricsMiddleware(observer HTTPObserver, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		observer.ObserveHTTP(r.Method, routePattern(r), recorder.status, time.Since(start))
+	}
+}
+
+// routePattern возвращает шаблон пути, выбранный ServeMux, без метода:
+// "GET /questions/{id}" -> "/questions/{id}". Пустая строка - маршрут не найден.
+func routePattern(r *http.Request) string {
+	route := r.Pattern
+	if _, path, found := strings.Cut(route, " "); found {
+		route = path
+	}
+	return route
+}
+
+// statusRecorder запоминает код ответа. Unwrap сохраняет доступ
+// к http.ResponseController для потоковых ответов.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (t *statusRecorder) WriteHeader(status int) {
+	if !t.wroteHeader {
+		t.status = status
+		t.wroteHeader = true
+	}
+	t.ResponseWriter.WriteHeader(status)
+}
+
+func (t *statusRecorder) Write(b []byte) (int, error) {
+	t.wroteHeader = true
+	return t.ResponseWriter.Write(b)
+}
+
+func (t *statusRecorder) Unwrap() http.ResponseWriter {
+	return t.ResponseWriter
+}
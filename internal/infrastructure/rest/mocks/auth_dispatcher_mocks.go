@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/Vy4cheSlave/qna/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAuthDispatcher is an autogenerated mock type for the AuthDispatcher type
+type MockAuthDispatcher struct {
+	mock.Mock
+}
+
+type MockAuthDispatcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthDispatcher) EXPECT() *MockAuthDispatcher_Expecter {
+	return &MockAuthDispatcher_Expecter{mock: &_m.Mock}
+}
+
+// Login provides a mock function with given fields: ctx, userName, password
+func (_m *MockAuthDispatcher) Login(ctx context.Context, userName *string, password *string) (*domain.TokenPair, error) {
+	ret := _m.Called(ctx, userName, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) (*domain.TokenPair, error)); ok {
+		return rf(ctx, userName, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) *domain.TokenPair); ok {
+		r0 = rf(ctx, userName, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, userName, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthDispatcher_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthDispatcher_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userName *string
+//   - password *string
+func (_e *MockAuthDispatcher_Expecter) Login(ctx interface{}, userName interface{}, password interface{}) *MockAuthDispatcher_Login_Call {
+	return &MockAuthDispatcher_Login_Call{Call: _e.mock.On("Login", ctx, userName, password)}
+}
+
+func (_c *MockAuthDispatcher_Login_Call) Run(run func(ctx context.Context, userName *string, password *string)) *MockAuthDispatcher_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockAuthDispatcher_Login_Call) Return(_a0 *domain.TokenPair, _a1 error) *MockAuthDispatcher_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthDispatcher_Login_Call) RunAndReturn(run func(context.Context, *string, *string) (*domain.TokenPair, error)) *MockAuthDispatcher_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, refreshToken
+func (_m *MockAuthDispatcher) Logout(ctx context.Context, refreshToken *string) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthDispatcher_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthDispatcher_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken *string
+func (_e *MockAuthDispatcher_Expecter) Logout(ctx interface{}, refreshToken interface{}) *MockAuthDispatcher_Logout_Call {
+	return &MockAuthDispatcher_Logout_Call{Call: _e.mock.On("Logout", ctx, refreshToken)}
+}
+
+func (_c *MockAuthDispatcher_Logout_Call) Run(run func(ctx context.Context, refreshToken *string)) *MockAuthDispatcher_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *MockAuthDispatcher_Logout_Call) Return(_a0 error) *MockAuthDispatcher_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthDispatcher_Logout_Call) RunAndReturn(run func(context.Context, *string) error) *MockAuthDispatcher_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *MockAuthDispatcher) Refresh(ctx context.Context, refreshToken *string) (*domain.TokenPair, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *domain.TokenPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) (*domain.TokenPair, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *domain.TokenPair); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TokenPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthDispatcher_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockAuthDispatcher_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken *string
+func (_e *MockAuthDispatcher_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *MockAuthDispatcher_Refresh_Call {
+	return &MockAuthDispatcher_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+}
+
+func (_c *MockAuthDispatcher_Refresh_Call) Run(run func(ctx context.Context, refreshToken *string)) *MockAuthDispatcher_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *MockAuthDispatcher_Refresh_Call) Return(_a0 *domain.TokenPair, _a1 error) *MockAuthDispatcher_Refresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthDispatcher_Refresh_Call) RunAndReturn(run func(context.Context, *string) (*domain.TokenPair, error)) *MockAuthDispatcher_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthDispatcher creates a new instance of MockAuthDispatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthDispatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthDispatcher {
+	mock := &MockAuthDispatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,126 @@ func (_m *MockQNADispatcher) EXPECT() *MockQNADispatcher_Expecter {
 	return &MockQNADispatcher_Expecter{mock: &_m.Mock}
 }
 
+// AcceptAnswer provides a mock function with given fields: ctx, questionId, answerId
+func (_m *MockQNADispatcher) AcceptAnswer(ctx context.Context, questionId int, answerId int) (*domain.Question, error) {
+	ret := _m.Called(ctx, questionId, answerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptAnswer")
+	}
+
+	var r0 *domain.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*domain.Question, error)); ok {
+		return rf(ctx, questionId, answerId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *domain.Question); ok {
+		r0 = rf(ctx, questionId, answerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, questionId, answerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_AcceptAnswer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptAnswer'
+type MockQNADispatcher_AcceptAnswer_Call struct {
+	*mock.Call
+}
+
+// AcceptAnswer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+//   - answerId int
+func (_e *MockQNADispatcher_Expecter) AcceptAnswer(ctx interface{}, questionId interface{}, answerId interface{}) *MockQNADispatcher_AcceptAnswer_Call {
+	return &MockQNADispatcher_AcceptAnswer_Call{Call: _e.mock.On("AcceptAnswer", ctx, questionId, answerId)}
+}
+
+func (_c *MockQNADispatcher_AcceptAnswer_Call) Run(run func(ctx context.Context, questionId int, answerId int)) *MockQNADispatcher_AcceptAnswer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_AcceptAnswer_Call) Return(_a0 *domain.Question, _a1 error) *MockQNADispatcher_AcceptAnswer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_AcceptAnswer_Call) RunAndReturn(run func(context.Context, int, int) (*domain.Question, error)) *MockQNADispatcher_AcceptAnswer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeQuestionStatus provides a mock function with given fields: ctx, questionId, status
+func (_m *MockQNADispatcher) ChangeQuestionStatus(ctx context.Context, questionId int, status domain.QuestionStatus) (*domain.Question, error) {
+	ret := _m.Called(ctx, questionId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeQuestionStatus")
+	}
+
+	var r0 *domain.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.QuestionStatus) (*domain.Question, error)); ok {
+		return rf(ctx, questionId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.QuestionStatus) *domain.Question); ok {
+		r0 = rf(ctx, questionId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.QuestionStatus) error); ok {
+		r1 = rf(ctx, questionId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_ChangeQuestionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeQuestionStatus'
+type MockQNADispatcher_ChangeQuestionStatus_Call struct {
+	*mock.Call
+}
+
+// ChangeQuestionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+//   - status domain.QuestionStatus
+func (_e *MockQNADispatcher_Expecter) ChangeQuestionStatus(ctx interface{}, questionId interface{}, status interface{}) *MockQNADispatcher_ChangeQuestionStatus_Call {
+	return &MockQNADispatcher_ChangeQuestionStatus_Call{Call: _e.mock.On("ChangeQuestionStatus", ctx, questionId, status)}
+}
+
+func (_c *MockQNADispatcher_ChangeQuestionStatus_Call) Run(run func(ctx context.Context, questionId int, status domain.QuestionStatus)) *MockQNADispatcher_ChangeQuestionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.QuestionStatus))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_ChangeQuestionStatus_Call) Return(_a0 *domain.Question, _a1 error) *MockQNADispatcher_ChangeQuestionStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_ChangeQuestionStatus_Call) RunAndReturn(run func(context.Context, int, domain.QuestionStatus) (*domain.Question, error)) *MockQNADispatcher_ChangeQuestionStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAnswerToQuestion provides a mock function with given fields: ctx, answer
 func (_m *MockQNADispatcher) CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (int, error) {
 	ret := _m.Called(ctx, answer)
@@ -79,8 +199,65 @@ func (_c *MockQNADispatcher_CreateAnswerToQuestion_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CreateComment provides a mock function with given fields: ctx, comment
+func (_m *MockQNADispatcher) CreateComment(ctx context.Context, comment *domain.Comment) (int, error) {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment) (int, error)); ok {
+		return rf(ctx, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment) int); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Comment) error); ok {
+		r1 = rf(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockQNADispatcher_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment *domain.Comment
+func (_e *MockQNADispatcher_Expecter) CreateComment(ctx interface{}, comment interface{}) *MockQNADispatcher_CreateComment_Call {
+	return &MockQNADispatcher_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, comment)}
+}
+
+func (_c *MockQNADispatcher_CreateComment_Call) Run(run func(ctx context.Context, comment *domain.Comment)) *MockQNADispatcher_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Comment))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_CreateComment_Call) Return(commentId int, err error) *MockQNADispatcher_CreateComment_Call {
+	_c.Call.Return(commentId, err)
+	return _c
+}
+
+func (_c *MockQNADispatcher_CreateComment_Call) RunAndReturn(run func(context.Context, *domain.Comment) (int, error)) *MockQNADispatcher_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateQuestion provides a mock function with given fields: ctx, question
-func (_m *MockQNADispatcher) CreateQuestion(ctx context.Context, question *string) (int, error) {
+func (_m *MockQNADispatcher) CreateQuestion(ctx context.Context, question *domain.Question) (int, error) {
 	ret := _m.Called(ctx, question)
 
 	if len(ret) == 0 {
@@ -89,16 +266,16 @@ func (_m *MockQNADispatcher) CreateQuestion(ctx context.Context, question *strin
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *string) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Question) (int, error)); ok {
 		return rf(ctx, question)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *string) int); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Question) int); ok {
 		r0 = rf(ctx, question)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Question) error); ok {
 		r1 = rf(ctx, question)
 	} else {
 		r1 = ret.Error(1)
@@ -114,14 +291,14 @@ type MockQNADispatcher_CreateQuestion_Call struct {
 
 // CreateQuestion is a helper method to define mock.On call
 //   - ctx context.Context
-//   - question *string
+//   - question *domain.Question
 func (_e *MockQNADispatcher_Expecter) CreateQuestion(ctx interface{}, question interface{}) *MockQNADispatcher_CreateQuestion_Call {
 	return &MockQNADispatcher_CreateQuestion_Call{Call: _e.mock.On("CreateQuestion", ctx, question)}
 }
 
-func (_c *MockQNADispatcher_CreateQuestion_Call) Run(run func(ctx context.Context, question *string)) *MockQNADispatcher_CreateQuestion_Call {
+func (_c *MockQNADispatcher_CreateQuestion_Call) Run(run func(ctx context.Context, question *domain.Question)) *MockQNADispatcher_CreateQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*string))
+		run(args[0].(context.Context), args[1].(*domain.Question))
 	})
 	return _c
 }
@@ -131,14 +308,14 @@ func (_c *MockQNADispatcher_CreateQuestion_Call) Return(questionId int, err erro
 	return _c
 }
 
-func (_c *MockQNADispatcher_CreateQuestion_Call) RunAndReturn(run func(context.Context, *string) (int, error)) *MockQNADispatcher_CreateQuestion_Call {
+func (_c *MockQNADispatcher_CreateQuestion_Call) RunAndReturn(run func(context.Context, *domain.Question) (int, error)) *MockQNADispatcher_CreateQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, userName
-func (_m *MockQNADispatcher) CreateUser(ctx context.Context, userName *string) (*string, error) {
-	ret := _m.Called(ctx, userName)
+// CreateUser provides a mock function with given fields: ctx, userName, password
+func (_m *MockQNADispatcher) CreateUser(ctx context.Context, userName *string, password *string) (*string, error) {
+	ret := _m.Called(ctx, userName, password)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateUser")
@@ -146,19 +323,19 @@ func (_m *MockQNADispatcher) CreateUser(ctx context.Context, userName *string) (
 
 	var r0 *string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *string) (*string, error)); ok {
-		return rf(ctx, userName)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) (*string, error)); ok {
+		return rf(ctx, userName, password)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *string) *string); ok {
-		r0 = rf(ctx, userName)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) *string); ok {
+		r0 = rf(ctx, userName, password)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
-		r1 = rf(ctx, userName)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, userName, password)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,13 +351,14 @@ type MockQNADispatcher_CreateUser_Call struct {
 // CreateUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userName *string
-func (_e *MockQNADispatcher_Expecter) CreateUser(ctx interface{}, userName interface{}) *MockQNADispatcher_CreateUser_Call {
-	return &MockQNADispatcher_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, userName)}
+//   - password *string
+func (_e *MockQNADispatcher_Expecter) CreateUser(ctx interface{}, userName interface{}, password interface{}) *MockQNADispatcher_CreateUser_Call {
+	return &MockQNADispatcher_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, userName, password)}
 }
 
-func (_c *MockQNADispatcher_CreateUser_Call) Run(run func(ctx context.Context, userName *string)) *MockQNADispatcher_CreateUser_Call {
+func (_c *MockQNADispatcher_CreateUser_Call) Run(run func(ctx context.Context, userName *string, password *string)) *MockQNADispatcher_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*string))
+		run(args[0].(context.Context), args[1].(*string), args[2].(*string))
 	})
 	return _c
 }
@@ -190,7 +368,64 @@ func (_c *MockQNADispatcher_CreateUser_Call) Return(userId *string, err error) *
 	return _c
 }
 
-func (_c *MockQNADispatcher_CreateUser_Call) RunAndReturn(run func(context.Context, *string) (*string, error)) *MockQNADispatcher_CreateUser_Call {
+func (_c *MockQNADispatcher_CreateUser_Call) RunAndReturn(run func(context.Context, *string, *string) (*string, error)) *MockQNADispatcher_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, webhook
+func (_m *MockQNADispatcher) CreateWebhook(ctx context.Context, webhook *domain.Webhook) (int, error) {
+	ret := _m.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Webhook) (int, error)); ok {
+		return rf(ctx, webhook)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Webhook) int); ok {
+		r0 = rf(ctx, webhook)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Webhook) error); ok {
+		r1 = rf(ctx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockQNADispatcher_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *domain.Webhook
+func (_e *MockQNADispatcher_Expecter) CreateWebhook(ctx interface{}, webhook interface{}) *MockQNADispatcher_CreateWebhook_Call {
+	return &MockQNADispatcher_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, webhook)}
+}
+
+func (_c *MockQNADispatcher_CreateWebhook_Call) Run(run func(ctx context.Context, webhook *domain.Webhook)) *MockQNADispatcher_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_CreateWebhook_Call) Return(webhookId int, err error) *MockQNADispatcher_CreateWebhook_Call {
+	_c.Call.Return(webhookId, err)
+	return _c
+}
+
+func (_c *MockQNADispatcher_CreateWebhook_Call) RunAndReturn(run func(context.Context, *domain.Webhook) (int, error)) *MockQNADispatcher_CreateWebhook_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -242,6 +477,55 @@ func (_c *MockQNADispatcher_DeleteAnswer_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DeleteComment provides a mock function with given fields: ctx, parent, parentId, commentId
+func (_m *MockQNADispatcher) DeleteComment(ctx context.Context, parent domain.CommentParent, parentId int, commentId int) error {
+	ret := _m.Called(ctx, parent, parentId, commentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CommentParent, int, int) error); ok {
+		r0 = rf(ctx, parent, parentId, commentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQNADispatcher_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockQNADispatcher_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parent domain.CommentParent
+//   - parentId int
+//   - commentId int
+func (_e *MockQNADispatcher_Expecter) DeleteComment(ctx interface{}, parent interface{}, parentId interface{}, commentId interface{}) *MockQNADispatcher_DeleteComment_Call {
+	return &MockQNADispatcher_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, parent, parentId, commentId)}
+}
+
+func (_c *MockQNADispatcher_DeleteComment_Call) Run(run func(ctx context.Context, parent domain.CommentParent, parentId int, commentId int)) *MockQNADispatcher_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CommentParent), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_DeleteComment_Call) Return(_a0 error) *MockQNADispatcher_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQNADispatcher_DeleteComment_Call) RunAndReturn(run func(context.Context, domain.CommentParent, int, int) error) *MockQNADispatcher_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteQuestionAndAnswers provides a mock function with given fields: ctx, questionId
 func (_m *MockQNADispatcher) DeleteQuestionAndAnswers(ctx context.Context, questionId int) error {
 	ret := _m.Called(ctx, questionId)
@@ -336,6 +620,53 @@ func (_c *MockQNADispatcher_DeleteUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DeleteWebhook provides a mock function with given fields: ctx, webhookId
+func (_m *MockQNADispatcher) DeleteWebhook(ctx context.Context, webhookId int) error {
+	ret := _m.Called(ctx, webhookId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, webhookId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQNADispatcher_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockQNADispatcher_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookId int
+func (_e *MockQNADispatcher_Expecter) DeleteWebhook(ctx interface{}, webhookId interface{}) *MockQNADispatcher_DeleteWebhook_Call {
+	return &MockQNADispatcher_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhookId)}
+}
+
+func (_c *MockQNADispatcher_DeleteWebhook_Call) Run(run func(ctx context.Context, webhookId int)) *MockQNADispatcher_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_DeleteWebhook_Call) Return(_a0 error) *MockQNADispatcher_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQNADispatcher_DeleteWebhook_Call) RunAndReturn(run func(context.Context, int) error) *MockQNADispatcher_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAnswer provides a mock function with given fields: ctx, answerId
 func (_m *MockQNADispatcher) GetAnswer(ctx context.Context, answerId int) (*domain.Answer, error) {
 	ret := _m.Called(ctx, answerId)
@@ -395,54 +726,173 @@ func (_c *MockQNADispatcher_GetAnswer_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// GetQuestionAndAnswers provides a mock function with given fields: ctx, questionId
-func (_m *MockQNADispatcher) GetQuestionAndAnswers(ctx context.Context, questionId int) (*domain.Question, *[]domain.Answer, error) {
-	ret := _m.Called(ctx, questionId)
+// GetAnswerRevisions provides a mock function with given fields: ctx, answerId
+func (_m *MockQNADispatcher) GetAnswerRevisions(ctx context.Context, answerId int) (*[]domain.Revision, error) {
+	ret := _m.Called(ctx, answerId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQuestionAndAnswers")
+		panic("no return value specified for GetAnswerRevisions")
 	}
 
-	var r0 *domain.Question
-	var r1 *[]domain.Answer
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Question, *[]domain.Answer, error)); ok {
-		return rf(ctx, questionId)
+	var r0 *[]domain.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*[]domain.Revision, error)); ok {
+		return rf(ctx, answerId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Question); ok {
-		r0 = rf(ctx, questionId)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *[]domain.Revision); ok {
+		r0 = rf(ctx, answerId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Question)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, int) *[]domain.Answer); ok {
-		r1 = rf(ctx, questionId)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*[]domain.Answer)
+			r0 = ret.Get(0).(*[]domain.Revision)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
-		r2 = rf(ctx, questionId)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, answerId)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockQNADispatcher_GetQuestionAndAnswers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionAndAnswers'
-type MockQNADispatcher_GetQuestionAndAnswers_Call struct {
+// MockQNADispatcher_GetAnswerRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnswerRevisions'
+type MockQNADispatcher_GetAnswerRevisions_Call struct {
 	*mock.Call
 }
 
-// GetQuestionAndAnswers is a helper method to define mock.On call
+// GetAnswerRevisions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - questionId int
-func (_e *MockQNADispatcher_Expecter) GetQuestionAndAnswers(ctx interface{}, questionId interface{}) *MockQNADispatcher_GetQuestionAndAnswers_Call {
+//   - answerId int
+func (_e *MockQNADispatcher_Expecter) GetAnswerRevisions(ctx interface{}, answerId interface{}) *MockQNADispatcher_GetAnswerRevisions_Call {
+	return &MockQNADispatcher_GetAnswerRevisions_Call{Call: _e.mock.On("GetAnswerRevisions", ctx, answerId)}
+}
+
+func (_c *MockQNADispatcher_GetAnswerRevisions_Call) Run(run func(ctx context.Context, answerId int)) *MockQNADispatcher_GetAnswerRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetAnswerRevisions_Call) Return(_a0 *[]domain.Revision, _a1 error) *MockQNADispatcher_GetAnswerRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetAnswerRevisions_Call) RunAndReturn(run func(context.Context, int) (*[]domain.Revision, error)) *MockQNADispatcher_GetAnswerRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComments provides a mock function with given fields: ctx, parent, parentId
+func (_m *MockQNADispatcher) GetComments(ctx context.Context, parent domain.CommentParent, parentId int) (*[]domain.Comment, error) {
+	ret := _m.Called(ctx, parent, parentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComments")
+	}
+
+	var r0 *[]domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CommentParent, int) (*[]domain.Comment, error)); ok {
+		return rf(ctx, parent, parentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CommentParent, int) *[]domain.Comment); ok {
+		r0 = rf(ctx, parent, parentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CommentParent, int) error); ok {
+		r1 = rf(ctx, parent, parentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_GetComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComments'
+type MockQNADispatcher_GetComments_Call struct {
+	*mock.Call
+}
+
+// GetComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parent domain.CommentParent
+//   - parentId int
+func (_e *MockQNADispatcher_Expecter) GetComments(ctx interface{}, parent interface{}, parentId interface{}) *MockQNADispatcher_GetComments_Call {
+	return &MockQNADispatcher_GetComments_Call{Call: _e.mock.On("GetComments", ctx, parent, parentId)}
+}
+
+func (_c *MockQNADispatcher_GetComments_Call) Run(run func(ctx context.Context, parent domain.CommentParent, parentId int)) *MockQNADispatcher_GetComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CommentParent), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetComments_Call) Return(_a0 *[]domain.Comment, _a1 error) *MockQNADispatcher_GetComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetComments_Call) RunAndReturn(run func(context.Context, domain.CommentParent, int) (*[]domain.Comment, error)) *MockQNADispatcher_GetComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionAndAnswers provides a mock function with given fields: ctx, questionId
+func (_m *MockQNADispatcher) GetQuestionAndAnswers(ctx context.Context, questionId int) (*domain.Question, *[]domain.Answer, error) {
+	ret := _m.Called(ctx, questionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionAndAnswers")
+	}
+
+	var r0 *domain.Question
+	var r1 *[]domain.Answer
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Question, *[]domain.Answer, error)); ok {
+		return rf(ctx, questionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Question); ok {
+		r0 = rf(ctx, questionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) *[]domain.Answer); ok {
+		r1 = rf(ctx, questionId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*[]domain.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(ctx, questionId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockQNADispatcher_GetQuestionAndAnswers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionAndAnswers'
+type MockQNADispatcher_GetQuestionAndAnswers_Call struct {
+	*mock.Call
+}
+
+// GetQuestionAndAnswers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+func (_e *MockQNADispatcher_Expecter) GetQuestionAndAnswers(ctx interface{}, questionId interface{}) *MockQNADispatcher_GetQuestionAndAnswers_Call {
 	return &MockQNADispatcher_GetQuestionAndAnswers_Call{Call: _e.mock.On("GetQuestionAndAnswers", ctx, questionId)}
 }
 
@@ -463,29 +913,29 @@ func (_c *MockQNADispatcher_GetQuestionAndAnswers_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// GetQuestions provides a mock function with given fields: ctx
-func (_m *MockQNADispatcher) GetQuestions(ctx context.Context) (*[]domain.Question, error) {
-	ret := _m.Called(ctx)
+// GetQuestionRevisions provides a mock function with given fields: ctx, questionId
+func (_m *MockQNADispatcher) GetQuestionRevisions(ctx context.Context, questionId int) (*[]domain.Revision, error) {
+	ret := _m.Called(ctx, questionId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQuestions")
+		panic("no return value specified for GetQuestionRevisions")
 	}
 
-	var r0 *[]domain.Question
+	var r0 *[]domain.Revision
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]domain.Question, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*[]domain.Revision, error)); ok {
+		return rf(ctx, questionId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]domain.Question); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *[]domain.Revision); ok {
+		r0 = rf(ctx, questionId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]domain.Question)
+			r0 = ret.Get(0).(*[]domain.Revision)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -493,6 +943,74 @@ func (_m *MockQNADispatcher) GetQuestions(ctx context.Context) (*[]domain.Questi
 	return r0, r1
 }
 
+// MockQNADispatcher_GetQuestionRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionRevisions'
+type MockQNADispatcher_GetQuestionRevisions_Call struct {
+	*mock.Call
+}
+
+// GetQuestionRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+func (_e *MockQNADispatcher_Expecter) GetQuestionRevisions(ctx interface{}, questionId interface{}) *MockQNADispatcher_GetQuestionRevisions_Call {
+	return &MockQNADispatcher_GetQuestionRevisions_Call{Call: _e.mock.On("GetQuestionRevisions", ctx, questionId)}
+}
+
+func (_c *MockQNADispatcher_GetQuestionRevisions_Call) Run(run func(ctx context.Context, questionId int)) *MockQNADispatcher_GetQuestionRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetQuestionRevisions_Call) Return(_a0 *[]domain.Revision, _a1 error) *MockQNADispatcher_GetQuestionRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetQuestionRevisions_Call) RunAndReturn(run func(context.Context, int) (*[]domain.Revision, error)) *MockQNADispatcher_GetQuestionRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestions provides a mock function with given fields: ctx, params, filter
+func (_m *MockQNADispatcher) GetQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error) {
+	ret := _m.Called(ctx, params, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestions")
+	}
+
+	var r0 *[]domain.Question
+	var r1 *domain.Page
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListParams, *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error)); ok {
+		return rf(ctx, params, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListParams, *domain.QuestionFilter) *[]domain.Question); ok {
+		r0 = rf(ctx, params, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ListParams, *domain.QuestionFilter) *domain.Page); ok {
+		r1 = rf(ctx, params, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *domain.ListParams, *domain.QuestionFilter) error); ok {
+		r2 = rf(ctx, params, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // MockQNADispatcher_GetQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestions'
 type MockQNADispatcher_GetQuestions_Call struct {
 	*mock.Call
@@ -500,50 +1018,180 @@ type MockQNADispatcher_GetQuestions_Call struct {
 
 // GetQuestions is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQNADispatcher_Expecter) GetQuestions(ctx interface{}) *MockQNADispatcher_GetQuestions_Call {
-	return &MockQNADispatcher_GetQuestions_Call{Call: _e.mock.On("GetQuestions", ctx)}
+//   - params *domain.ListParams
+//   - filter *domain.QuestionFilter
+func (_e *MockQNADispatcher_Expecter) GetQuestions(ctx interface{}, params interface{}, filter interface{}) *MockQNADispatcher_GetQuestions_Call {
+	return &MockQNADispatcher_GetQuestions_Call{Call: _e.mock.On("GetQuestions", ctx, params, filter)}
+}
+
+func (_c *MockQNADispatcher_GetQuestions_Call) Run(run func(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter)) *MockQNADispatcher_GetQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ListParams), args[2].(*domain.QuestionFilter))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetQuestions_Call) Return(_a0 *[]domain.Question, _a1 *domain.Page, _a2 error) *MockQNADispatcher_GetQuestions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetQuestions_Call) RunAndReturn(run func(context.Context, *domain.ListParams, *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error)) *MockQNADispatcher_GetQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagQuestions provides a mock function with given fields: ctx, name, params
+func (_m *MockQNADispatcher) GetTagQuestions(ctx context.Context, name string, params *domain.ListParams) (*[]domain.Question, *domain.Page, error) {
+	ret := _m.Called(ctx, name, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagQuestions")
+	}
+
+	var r0 *[]domain.Question
+	var r1 *domain.Page
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ListParams) (*[]domain.Question, *domain.Page, error)); ok {
+		return rf(ctx, name, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ListParams) *[]domain.Question); ok {
+		r0 = rf(ctx, name, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.ListParams) *domain.Page); ok {
+		r1 = rf(ctx, name, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *domain.ListParams) error); ok {
+		r2 = rf(ctx, name, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockQNADispatcher_GetTagQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagQuestions'
+type MockQNADispatcher_GetTagQuestions_Call struct {
+	*mock.Call
+}
+
+// GetTagQuestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - params *domain.ListParams
+func (_e *MockQNADispatcher_Expecter) GetTagQuestions(ctx interface{}, name interface{}, params interface{}) *MockQNADispatcher_GetTagQuestions_Call {
+	return &MockQNADispatcher_GetTagQuestions_Call{Call: _e.mock.On("GetTagQuestions", ctx, name, params)}
+}
+
+func (_c *MockQNADispatcher_GetTagQuestions_Call) Run(run func(ctx context.Context, name string, params *domain.ListParams)) *MockQNADispatcher_GetTagQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.ListParams))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetTagQuestions_Call) Return(_a0 *[]domain.Question, _a1 *domain.Page, _a2 error) *MockQNADispatcher_GetTagQuestions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetTagQuestions_Call) RunAndReturn(run func(context.Context, string, *domain.ListParams) (*[]domain.Question, *domain.Page, error)) *MockQNADispatcher_GetTagQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx, query
+func (_m *MockQNADispatcher) GetTags(ctx context.Context, query *domain.TagQuery) (*[]domain.Tag, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 *[]domain.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TagQuery) (*[]domain.Tag, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TagQuery) *[]domain.Tag); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.TagQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockQNADispatcher_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *domain.TagQuery
+func (_e *MockQNADispatcher_Expecter) GetTags(ctx interface{}, query interface{}) *MockQNADispatcher_GetTags_Call {
+	return &MockQNADispatcher_GetTags_Call{Call: _e.mock.On("GetTags", ctx, query)}
 }
 
-func (_c *MockQNADispatcher_GetQuestions_Call) Run(run func(ctx context.Context)) *MockQNADispatcher_GetQuestions_Call {
+func (_c *MockQNADispatcher_GetTags_Call) Run(run func(ctx context.Context, query *domain.TagQuery)) *MockQNADispatcher_GetTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*domain.TagQuery))
 	})
 	return _c
 }
 
-func (_c *MockQNADispatcher_GetQuestions_Call) Return(_a0 *[]domain.Question, _a1 error) *MockQNADispatcher_GetQuestions_Call {
+func (_c *MockQNADispatcher_GetTags_Call) Return(_a0 *[]domain.Tag, _a1 error) *MockQNADispatcher_GetTags_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQNADispatcher_GetQuestions_Call) RunAndReturn(run func(context.Context) (*[]domain.Question, error)) *MockQNADispatcher_GetQuestions_Call {
+func (_c *MockQNADispatcher_GetTags_Call) RunAndReturn(run func(context.Context, *domain.TagQuery) (*[]domain.Tag, error)) *MockQNADispatcher_GetTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsers provides a mock function with given fields: ctx
-func (_m *MockQNADispatcher) GetUsers(ctx context.Context) (*[]domain.User, error) {
-	ret := _m.Called(ctx)
+// GetThreadComments provides a mock function with given fields: ctx, questionId
+func (_m *MockQNADispatcher) GetThreadComments(ctx context.Context, questionId int) (*[]domain.Comment, error) {
+	ret := _m.Called(ctx, questionId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsers")
+		panic("no return value specified for GetThreadComments")
 	}
 
-	var r0 *[]domain.User
+	var r0 *[]domain.Comment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*[]domain.User, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*[]domain.Comment, error)); ok {
+		return rf(ctx, questionId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *[]domain.User); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int) *[]domain.Comment); ok {
+		r0 = rf(ctx, questionId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]domain.User)
+			r0 = ret.Get(0).(*[]domain.Comment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -551,30 +1199,835 @@ func (_m *MockQNADispatcher) GetUsers(ctx context.Context) (*[]domain.User, erro
 	return r0, r1
 }
 
-// MockQNADispatcher_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
-type MockQNADispatcher_GetUsers_Call struct {
+// MockQNADispatcher_GetThreadComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThreadComments'
+type MockQNADispatcher_GetThreadComments_Call struct {
 	*mock.Call
 }
 
-// GetUsers is a helper method to define mock.On call
+// GetThreadComments is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQNADispatcher_Expecter) GetUsers(ctx interface{}) *MockQNADispatcher_GetUsers_Call {
-	return &MockQNADispatcher_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx)}
+//   - questionId int
+func (_e *MockQNADispatcher_Expecter) GetThreadComments(ctx interface{}, questionId interface{}) *MockQNADispatcher_GetThreadComments_Call {
+	return &MockQNADispatcher_GetThreadComments_Call{Call: _e.mock.On("GetThreadComments", ctx, questionId)}
+}
+
+func (_c *MockQNADispatcher_GetThreadComments_Call) Run(run func(ctx context.Context, questionId int)) *MockQNADispatcher_GetThreadComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetThreadComments_Call) Return(_a0 *[]domain.Comment, _a1 error) *MockQNADispatcher_GetThreadComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetThreadComments_Call) RunAndReturn(run func(context.Context, int) (*[]domain.Comment, error)) *MockQNADispatcher_GetThreadComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrash provides a mock function with given fields: ctx, query
+func (_m *MockQNADispatcher) GetTrash(ctx context.Context, query *domain.TrashQuery) (*[]domain.TrashItem, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrash")
+	}
+
+	var r0 *[]domain.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TrashQuery) (*[]domain.TrashItem, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TrashQuery) *[]domain.TrashItem); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.TrashQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_GetTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrash'
+type MockQNADispatcher_GetTrash_Call struct {
+	*mock.Call
+}
+
+// GetTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *domain.TrashQuery
+func (_e *MockQNADispatcher_Expecter) GetTrash(ctx interface{}, query interface{}) *MockQNADispatcher_GetTrash_Call {
+	return &MockQNADispatcher_GetTrash_Call{Call: _e.mock.On("GetTrash", ctx, query)}
+}
+
+func (_c *MockQNADispatcher_GetTrash_Call) Run(run func(ctx context.Context, query *domain.TrashQuery)) *MockQNADispatcher_GetTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.TrashQuery))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetTrash_Call) Return(_a0 *[]domain.TrashItem, _a1 error) *MockQNADispatcher_GetTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetTrash_Call) RunAndReturn(run func(context.Context, *domain.TrashQuery) (*[]domain.TrashItem, error)) *MockQNADispatcher_GetTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx, params
+func (_m *MockQNADispatcher) GetUsers(ctx context.Context, params *domain.ListParams) (*[]domain.User, *domain.Page, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 *[]domain.User
+	var r1 *domain.Page
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListParams) (*[]domain.User, *domain.Page, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListParams) *[]domain.User); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ListParams) *domain.Page); ok {
+		r1 = rf(ctx, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *domain.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockQNADispatcher_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type MockQNADispatcher_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *domain.ListParams
+func (_e *MockQNADispatcher_Expecter) GetUsers(ctx interface{}, params interface{}) *MockQNADispatcher_GetUsers_Call {
+	return &MockQNADispatcher_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, params)}
+}
+
+func (_c *MockQNADispatcher_GetUsers_Call) Run(run func(ctx context.Context, params *domain.ListParams)) *MockQNADispatcher_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ListParams))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetUsers_Call) Return(_a0 *[]domain.User, _a1 *domain.Page, _a2 error) *MockQNADispatcher_GetUsers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetUsers_Call) RunAndReturn(run func(context.Context, *domain.ListParams) (*[]domain.User, *domain.Page, error)) *MockQNADispatcher_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: ctx, query
+func (_m *MockQNADispatcher) GetWebhookDeliveries(ctx context.Context, query *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 *[]domain.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeliveryQuery) *[]domain.WebhookDelivery); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.DeliveryQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockQNADispatcher_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *domain.DeliveryQuery
+func (_e *MockQNADispatcher_Expecter) GetWebhookDeliveries(ctx interface{}, query interface{}) *MockQNADispatcher_GetWebhookDeliveries_Call {
+	return &MockQNADispatcher_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, query)}
+}
+
+func (_c *MockQNADispatcher_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, query *domain.DeliveryQuery)) *MockQNADispatcher_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.DeliveryQuery))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetWebhookDeliveries_Call) Return(_a0 *[]domain.WebhookDelivery, _a1 error) *MockQNADispatcher_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error)) *MockQNADispatcher_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreAnswer provides a mock function with given fields: ctx, answerId
+func (_m *MockQNADispatcher) RestoreAnswer(ctx context.Context, answerId int) error {
+	ret := _m.Called(ctx, answerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreAnswer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, answerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQNADispatcher_RestoreAnswer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreAnswer'
+type MockQNADispatcher_RestoreAnswer_Call struct {
+	*mock.Call
+}
+
+// RestoreAnswer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - answerId int
+func (_e *MockQNADispatcher_Expecter) RestoreAnswer(ctx interface{}, answerId interface{}) *MockQNADispatcher_RestoreAnswer_Call {
+	return &MockQNADispatcher_RestoreAnswer_Call{Call: _e.mock.On("RestoreAnswer", ctx, answerId)}
+}
+
+func (_c *MockQNADispatcher_RestoreAnswer_Call) Run(run func(ctx context.Context, answerId int)) *MockQNADispatcher_RestoreAnswer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_RestoreAnswer_Call) Return(_a0 error) *MockQNADispatcher_RestoreAnswer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQNADispatcher_RestoreAnswer_Call) RunAndReturn(run func(context.Context, int) error) *MockQNADispatcher_RestoreAnswer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreQuestion provides a mock function with given fields: ctx, questionId
+func (_m *MockQNADispatcher) RestoreQuestion(ctx context.Context, questionId int) error {
+	ret := _m.Called(ctx, questionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreQuestion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, questionId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQNADispatcher_RestoreQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreQuestion'
+type MockQNADispatcher_RestoreQuestion_Call struct {
+	*mock.Call
+}
+
+// RestoreQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+func (_e *MockQNADispatcher_Expecter) RestoreQuestion(ctx interface{}, questionId interface{}) *MockQNADispatcher_RestoreQuestion_Call {
+	return &MockQNADispatcher_RestoreQuestion_Call{Call: _e.mock.On("RestoreQuestion", ctx, questionId)}
+}
+
+func (_c *MockQNADispatcher_RestoreQuestion_Call) Run(run func(ctx context.Context, questionId int)) *MockQNADispatcher_RestoreQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_RestoreQuestion_Call) Return(_a0 error) *MockQNADispatcher_RestoreQuestion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQNADispatcher_RestoreQuestion_Call) RunAndReturn(run func(context.Context, int) error) *MockQNADispatcher_RestoreQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function with given fields: ctx, userId
+func (_m *MockQNADispatcher) RestoreUser(ctx context.Context, userId *string) error {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQNADispatcher_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type MockQNADispatcher_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId *string
+func (_e *MockQNADispatcher_Expecter) RestoreUser(ctx interface{}, userId interface{}) *MockQNADispatcher_RestoreUser_Call {
+	return &MockQNADispatcher_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, userId)}
+}
+
+func (_c *MockQNADispatcher_RestoreUser_Call) Run(run func(ctx context.Context, userId *string)) *MockQNADispatcher_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_RestoreUser_Call) Return(_a0 error) *MockQNADispatcher_RestoreUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQNADispatcher_RestoreUser_Call) RunAndReturn(run func(context.Context, *string) error) *MockQNADispatcher_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetractAnswerVote provides a mock function with given fields: ctx, answerId
+func (_m *MockQNADispatcher) RetractAnswerVote(ctx context.Context, answerId int) (*domain.VoteResult, error) {
+	ret := _m.Called(ctx, answerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetractAnswerVote")
+	}
+
+	var r0 *domain.VoteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.VoteResult, error)); ok {
+		return rf(ctx, answerId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.VoteResult); ok {
+		r0 = rf(ctx, answerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VoteResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, answerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_RetractAnswerVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetractAnswerVote'
+type MockQNADispatcher_RetractAnswerVote_Call struct {
+	*mock.Call
+}
+
+// RetractAnswerVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - answerId int
+func (_e *MockQNADispatcher_Expecter) RetractAnswerVote(ctx interface{}, answerId interface{}) *MockQNADispatcher_RetractAnswerVote_Call {
+	return &MockQNADispatcher_RetractAnswerVote_Call{Call: _e.mock.On("RetractAnswerVote", ctx, answerId)}
+}
+
+func (_c *MockQNADispatcher_RetractAnswerVote_Call) Run(run func(ctx context.Context, answerId int)) *MockQNADispatcher_RetractAnswerVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_RetractAnswerVote_Call) Return(_a0 *domain.VoteResult, _a1 error) *MockQNADispatcher_RetractAnswerVote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_RetractAnswerVote_Call) RunAndReturn(run func(context.Context, int) (*domain.VoteResult, error)) *MockQNADispatcher_RetractAnswerVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetractQuestionVote provides a mock function with given fields: ctx, questionId
+func (_m *MockQNADispatcher) RetractQuestionVote(ctx context.Context, questionId int) (*domain.VoteResult, error) {
+	ret := _m.Called(ctx, questionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetractQuestionVote")
+	}
+
+	var r0 *domain.VoteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.VoteResult, error)); ok {
+		return rf(ctx, questionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.VoteResult); ok {
+		r0 = rf(ctx, questionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VoteResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_RetractQuestionVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetractQuestionVote'
+type MockQNADispatcher_RetractQuestionVote_Call struct {
+	*mock.Call
+}
+
+// RetractQuestionVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+func (_e *MockQNADispatcher_Expecter) RetractQuestionVote(ctx interface{}, questionId interface{}) *MockQNADispatcher_RetractQuestionVote_Call {
+	return &MockQNADispatcher_RetractQuestionVote_Call{Call: _e.mock.On("RetractQuestionVote", ctx, questionId)}
+}
+
+func (_c *MockQNADispatcher_RetractQuestionVote_Call) Run(run func(ctx context.Context, questionId int)) *MockQNADispatcher_RetractQuestionVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_RetractQuestionVote_Call) Return(_a0 *domain.VoteResult, _a1 error) *MockQNADispatcher_RetractQuestionVote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_RetractQuestionVote_Call) RunAndReturn(run func(context.Context, int) (*domain.VoteResult, error)) *MockQNADispatcher_RetractQuestionVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, query
+func (_m *MockQNADispatcher) Search(ctx context.Context, query *domain.SearchQuery) (*[]domain.SearchHit, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 *[]domain.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SearchQuery) (*[]domain.SearchHit, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SearchQuery) *[]domain.SearchHit); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]domain.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SearchQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockQNADispatcher_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *domain.SearchQuery
+func (_e *MockQNADispatcher_Expecter) Search(ctx interface{}, query interface{}) *MockQNADispatcher_Search_Call {
+	return &MockQNADispatcher_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+}
+
+func (_c *MockQNADispatcher_Search_Call) Run(run func(ctx context.Context, query *domain.SearchQuery)) *MockQNADispatcher_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.SearchQuery))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_Search_Call) Return(_a0 *[]domain.SearchHit, _a1 error) *MockQNADispatcher_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_Search_Call) RunAndReturn(run func(context.Context, *domain.SearchQuery) (*[]domain.SearchHit, error)) *MockQNADispatcher_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAnswer provides a mock function with given fields: ctx, answerId, patch
+func (_m *MockQNADispatcher) UpdateAnswer(ctx context.Context, answerId int, patch *domain.AnswerPatch) (*domain.Answer, error) {
+	ret := _m.Called(ctx, answerId, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAnswer")
+	}
+
+	var r0 *domain.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.AnswerPatch) (*domain.Answer, error)); ok {
+		return rf(ctx, answerId, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.AnswerPatch) *domain.Answer); ok {
+		r0 = rf(ctx, answerId, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.AnswerPatch) error); ok {
+		r1 = rf(ctx, answerId, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_UpdateAnswer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAnswer'
+type MockQNADispatcher_UpdateAnswer_Call struct {
+	*mock.Call
+}
+
+// UpdateAnswer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - answerId int
+//   - patch *domain.AnswerPatch
+func (_e *MockQNADispatcher_Expecter) UpdateAnswer(ctx interface{}, answerId interface{}, patch interface{}) *MockQNADispatcher_UpdateAnswer_Call {
+	return &MockQNADispatcher_UpdateAnswer_Call{Call: _e.mock.On("UpdateAnswer", ctx, answerId, patch)}
+}
+
+func (_c *MockQNADispatcher_UpdateAnswer_Call) Run(run func(ctx context.Context, answerId int, patch *domain.AnswerPatch)) *MockQNADispatcher_UpdateAnswer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.AnswerPatch))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_UpdateAnswer_Call) Return(_a0 *domain.Answer, _a1 error) *MockQNADispatcher_UpdateAnswer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_UpdateAnswer_Call) RunAndReturn(run func(context.Context, int, *domain.AnswerPatch) (*domain.Answer, error)) *MockQNADispatcher_UpdateAnswer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateQuestion provides a mock function with given fields: ctx, questionId, patch
+func (_m *MockQNADispatcher) UpdateQuestion(ctx context.Context, questionId int, patch *domain.QuestionPatch) (*domain.Question, error) {
+	ret := _m.Called(ctx, questionId, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuestion")
+	}
+
+	var r0 *domain.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.QuestionPatch) (*domain.Question, error)); ok {
+		return rf(ctx, questionId, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *domain.QuestionPatch) *domain.Question); ok {
+		r0 = rf(ctx, questionId, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *domain.QuestionPatch) error); ok {
+		r1 = rf(ctx, questionId, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_UpdateQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQuestion'
+type MockQNADispatcher_UpdateQuestion_Call struct {
+	*mock.Call
+}
+
+// UpdateQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+//   - patch *domain.QuestionPatch
+func (_e *MockQNADispatcher_Expecter) UpdateQuestion(ctx interface{}, questionId interface{}, patch interface{}) *MockQNADispatcher_UpdateQuestion_Call {
+	return &MockQNADispatcher_UpdateQuestion_Call{Call: _e.mock.On("UpdateQuestion", ctx, questionId, patch)}
+}
+
+func (_c *MockQNADispatcher_UpdateQuestion_Call) Run(run func(ctx context.Context, questionId int, patch *domain.QuestionPatch)) *MockQNADispatcher_UpdateQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*domain.QuestionPatch))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_UpdateQuestion_Call) Return(_a0 *domain.Question, _a1 error) *MockQNADispatcher_UpdateQuestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_UpdateQuestion_Call) RunAndReturn(run func(context.Context, int, *domain.QuestionPatch) (*domain.Question, error)) *MockQNADispatcher_UpdateQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function with given fields: ctx, userId, patch
+func (_m *MockQNADispatcher) UpdateUser(ctx context.Context, userId *string, patch *domain.UserPatch) (*domain.User, error) {
+	ret := _m.Called(ctx, userId, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *domain.UserPatch) (*domain.User, error)); ok {
+		return rf(ctx, userId, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *domain.UserPatch) *domain.User); ok {
+		r0 = rf(ctx, userId, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *domain.UserPatch) error); ok {
+		r1 = rf(ctx, userId, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockQNADispatcher_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId *string
+//   - patch *domain.UserPatch
+func (_e *MockQNADispatcher_Expecter) UpdateUser(ctx interface{}, userId interface{}, patch interface{}) *MockQNADispatcher_UpdateUser_Call {
+	return &MockQNADispatcher_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userId, patch)}
+}
+
+func (_c *MockQNADispatcher_UpdateUser_Call) Run(run func(ctx context.Context, userId *string, patch *domain.UserPatch)) *MockQNADispatcher_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(*domain.UserPatch))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_UpdateUser_Call) Return(_a0 *domain.User, _a1 error) *MockQNADispatcher_UpdateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_UpdateUser_Call) RunAndReturn(run func(context.Context, *string, *domain.UserPatch) (*domain.User, error)) *MockQNADispatcher_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoteAnswer provides a mock function with given fields: ctx, answerId, value
+func (_m *MockQNADispatcher) VoteAnswer(ctx context.Context, answerId int, value domain.VoteValue) (*domain.VoteResult, error) {
+	ret := _m.Called(ctx, answerId, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoteAnswer")
+	}
+
+	var r0 *domain.VoteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.VoteValue) (*domain.VoteResult, error)); ok {
+		return rf(ctx, answerId, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.VoteValue) *domain.VoteResult); ok {
+		r0 = rf(ctx, answerId, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VoteResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.VoteValue) error); ok {
+		r1 = rf(ctx, answerId, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_VoteAnswer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoteAnswer'
+type MockQNADispatcher_VoteAnswer_Call struct {
+	*mock.Call
+}
+
+// VoteAnswer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - answerId int
+//   - value domain.VoteValue
+func (_e *MockQNADispatcher_Expecter) VoteAnswer(ctx interface{}, answerId interface{}, value interface{}) *MockQNADispatcher_VoteAnswer_Call {
+	return &MockQNADispatcher_VoteAnswer_Call{Call: _e.mock.On("VoteAnswer", ctx, answerId, value)}
+}
+
+func (_c *MockQNADispatcher_VoteAnswer_Call) Run(run func(ctx context.Context, answerId int, value domain.VoteValue)) *MockQNADispatcher_VoteAnswer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.VoteValue))
+	})
+	return _c
+}
+
+func (_c *MockQNADispatcher_VoteAnswer_Call) Return(_a0 *domain.VoteResult, _a1 error) *MockQNADispatcher_VoteAnswer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQNADispatcher_VoteAnswer_Call) RunAndReturn(run func(context.Context, int, domain.VoteValue) (*domain.VoteResult, error)) *MockQNADispatcher_VoteAnswer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VoteQuestion provides a mock function with given fields: ctx, questionId, value
+func (_m *MockQNADispatcher) VoteQuestion(ctx context.Context, questionId int, value domain.VoteValue) (*domain.VoteResult, error) {
+	ret := _m.Called(ctx, questionId, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VoteQuestion")
+	}
+
+	var r0 *domain.VoteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.VoteValue) (*domain.VoteResult, error)); ok {
+		return rf(ctx, questionId, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.VoteValue) *domain.VoteResult); ok {
+		r0 = rf(ctx, questionId, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VoteResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.VoteValue) error); ok {
+		r1 = rf(ctx, questionId, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQNADispatcher_VoteQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoteQuestion'
+type MockQNADispatcher_VoteQuestion_Call struct {
+	*mock.Call
+}
+
+// VoteQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId int
+//   - value domain.VoteValue
+func (_e *MockQNADispatcher_Expecter) VoteQuestion(ctx interface{}, questionId interface{}, value interface{}) *MockQNADispatcher_VoteQuestion_Call {
+	return &MockQNADispatcher_VoteQuestion_Call{Call: _e.mock.On("VoteQuestion", ctx, questionId, value)}
 }
 
-func (_c *MockQNADispatcher_GetUsers_Call) Run(run func(ctx context.Context)) *MockQNADispatcher_GetUsers_Call {
+func (_c *MockQNADispatcher_VoteQuestion_Call) Run(run func(ctx context.Context, questionId int, value domain.VoteValue)) *MockQNADispatcher_VoteQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(int), args[2].(domain.VoteValue))
 	})
 	return _c
 }
 
-func (_c *MockQNADispatcher_GetUsers_Call) Return(_a0 *[]domain.User, _a1 error) *MockQNADispatcher_GetUsers_Call {
+func (_c *MockQNADispatcher_VoteQuestion_Call) Return(_a0 *domain.VoteResult, _a1 error) *MockQNADispatcher_VoteQuestion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQNADispatcher_GetUsers_Call) RunAndReturn(run func(context.Context) (*[]domain.User, error)) *MockQNADispatcher_GetUsers_Call {
+func (_c *MockQNADispatcher_VoteQuestion_Call) RunAndReturn(run func(context.Context, int, domain.VoteValue) (*domain.VoteResult, error)) *MockQNADispatcher_VoteQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
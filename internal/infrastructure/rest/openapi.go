@@ -0,0 +1,40 @@
+package rest
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+)
+
+// openAPISpec - описание API в формате OpenAPI 3.1. Соответствие маршрутам и
+// DTO проверяется тестом TestOpenAPISpec.
+//
+//go:embed openapi/openapi.json
+var openAPISpec []byte
+
+// docsPage строит документацию по /openapi.json без сторонних ресурсов.
+//
+//go:embed openapi/docs.html
+var docsPage []byte
+
+func (t *serverAPI) OpenAPI(w http.ResponseWriter, r *http.Request) {
+	t.writeStatic(w, r, "application/json", openAPISpec)
+}
+
+func (t *serverAPI) Docs(w http.ResponseWriter, r *http.Request) {
+	t.writeStatic(w, r, "text/html; charset=utf-8", docsPage)
+}
+
+func (t *serverAPI) writeStatic(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Формирование ответа
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
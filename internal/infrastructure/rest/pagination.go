@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+// parseListParams разбирает параметры пагинации и фильтрации списков:
+// limit, order, cursor, created_after, created_before.
+func parseListParams(r *http.Request) (*domain.ListParams, error) {
+	query := r.URL.Query()
+	params := domain.ListParams{
+		Limit: domain.DefaultPageLimit,
+		Order: domain.SortDesc,
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil || limitInt < 1 || limitInt > domain.MaxPageLimit {
+			return nil, fmt.Errorf("\"limit\" must be an integer between 1 and %d", domain.MaxPageLimit)
+		}
+		params.Limit = limitInt
+	}
+
+	if order := query.Get("order"); order != "" {
+		switch domain.SortOrder(order) {
+		case domain.SortAsc, domain.SortDesc:
+			params.Order = domain.SortOrder(order)
+		default:
+			return nil, errors.New("\"order\" must be one of: asc, desc")
+		}
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		after, err := domain.DecodeCursor(cursor)
+		if err != nil {
+			return nil, errors.New("invalid \"cursor\"")
+		}
+		params.After = after
+	}
+
+	for key, target := range map[string]**time.Time{
+		"created_after":  &params.CreatedAfter,
+		"created_before": &params.CreatedBefore,
+	} {
+		if value := query.Get(key); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RFC 3339 timestamp for \"%s\"", key)
+			}
+			*target = &parsed
+		}
+	}
+
+	return &params, nil
+}
+
+// parseLimitOffset разбирает параметры limit и offset списков без курсора
+// (поиск, теги).
+func parseLimitOffset(query url.Values) (limit int, offset int, validationErr string) {
+	limit = domain.DefaultPageLimit
+	if value := query.Get("limit"); value != "" {
+		limitInt, err := strconv.Atoi(value)
+		if err != nil || limitInt < 1 || limitInt > domain.MaxPageLimit {
+			return 0, 0, fmt.Sprintf("\"limit\" must be an integer between 1 and %d", domain.MaxPageLimit)
+		}
+		limit = limitInt
+	}
+	if value := query.Get("offset"); value != "" {
+		offsetInt, err := strconv.Atoi(value)
+		if err != nil || offsetInt < 0 {
+			return 0, 0, "\"offset\" must be a non-negative integer"
+		}
+		offset = offsetInt
+	}
+	return limit, offset, ""
+}
+
+// setNextPageLink выставляет заголовок Link на следующую страницу и
+// возвращает метаданные пагинации для тела ответа.
+func setNextPageLink(w http.ResponseWriter, r *http.Request, params *domain.ListParams, page *domain.Page) *response.Meta {
+	meta := &response.Meta{Limit: params.Limit}
+	if page == nil || page.NextCursor == nil {
+		return meta
+	}
+
+	meta.NextCursor = page.NextCursor.Encode()
+
+	next := *r.URL
+	query := next.Query()
+	query.Set("cursor", meta.NextCursor)
+	query.Set("limit", strconv.Itoa(params.Limit))
+	next.RawQuery = query.Encode()
+	w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+
+	return meta
+}
@@ -0,0 +1,394 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	contentTypeMergePatch = "application/merge-patch+json"
+	contentTypeJSON       = "application/json"
+)
+
+// isMergePatch проверяет тип тела PATCH-запроса. Кроме типа из RFC 7396
+// принимается обычный application/json и запрос без заголовка.
+func isMergePatch(r *http.Request) bool {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == contentTypeMergePatch || mediaType == contentTypeJSON
+}
+
+// parsePathId разбирает положительный целочисленный идентификатор из пути.
+func parsePathId(r *http.Request) (int, string) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return 0, "invalid ID format for \"id\""
+	}
+	if id < 1 {
+		return 0, "ID must be a positive integer"
+	}
+	return id, ""
+}
+
+func (t *serverAPI) PatchQuestion(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.PatchQuestionRequest
+
+	// Десериализация JSON-запроса
+	if !isMergePatch(r) {
+		errorList = append(errorList, errors.New("unsupported content type"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusUnsupportedMediaType,
+			response.WithError(response.ErrCodeUnsupportedMedia, "Content-Type must be application/merge-patch+json"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusUnsupportedMediaType, &errorList)
+		return
+	}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	questionId, validationErr := parsePathId(r)
+	if validationErr == "" && req.Title.Null {
+		validationErr = "field \"title\" must not be null"
+	}
+	if validationErr == "" && req.Text.Null {
+		validationErr = "field \"text\" must not be null"
+	}
+	if validationErr == "" && !req.Title.Set && !req.Text.Set && !req.Tags.Set {
+		validationErr = "patch must contain \"title\", \"text\" or \"tags\""
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	patch := domain.QuestionPatch{
+		Title: req.Title.Ptr(),
+		Text:  req.Text.Ptr(),
+		Tags:  req.Tags.Ptr(),
+	}
+	// null для тегов снимает все теги
+	if req.Tags.Null {
+		patch.Tags = &[]string{}
+	}
+	question, err := t.service.UpdateQuestion(ctx, questionId, &patch)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*question),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) PatchAnswer(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.PatchAnswerRequest
+
+	// Десериализация JSON-запроса
+	if !isMergePatch(r) {
+		errorList = append(errorList, errors.New("unsupported content type"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusUnsupportedMediaType,
+			response.WithError(response.ErrCodeUnsupportedMedia, "Content-Type must be application/merge-patch+json"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusUnsupportedMediaType, &errorList)
+		return
+	}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	answerId, validationErr := parsePathId(r)
+	if validationErr == "" && req.Text.Null {
+		validationErr = "field \"text\" must not be null"
+	}
+	if validationErr == "" && !req.Text.Set {
+		validationErr = "patch must contain \"text\""
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	answer, err := t.service.UpdateAnswer(ctx, answerId, &domain.AnswerPatch{
+		Text: req.Text.Ptr(),
+	})
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*answer),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) PatchUser(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.PatchUserRequest
+	userId := r.PathValue("id")
+
+	// Десериализация JSON-запроса
+	if !isMergePatch(r) {
+		errorList = append(errorList, errors.New("unsupported content type"))
+		err := response.ReturnResponse(
+			w,
+			http.StatusUnsupportedMediaType,
+			response.WithError(response.ErrCodeUnsupportedMedia, "Content-Type must be application/merge-patch+json"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusUnsupportedMediaType, &errorList)
+		return
+	}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	var validationErr string
+	if _, err := uuid.Parse(userId); err != nil {
+		validationErr = "invalid UUID format for \"id\""
+	}
+	if validationErr == "" && req.Name.Null {
+		validationErr = "field \"name\" must not be null"
+	}
+	if validationErr == "" && req.Password.Null {
+		validationErr = "field \"password\" must not be null"
+	}
+	if validationErr == "" && !req.Name.Set && !req.Password.Set {
+		validationErr = "patch must contain \"name\" or \"password\""
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	user, err := t.service.UpdateUser(ctx, &userId, &domain.UserPatch{
+		Name:     req.Name.Ptr(),
+		Password: req.Password.Ptr(),
+	})
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*user),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) GetQuestionRevisions(w http.ResponseWriter, r *http.Request) {
+	t.getRevisions(w, r, t.service.GetQuestionRevisions)
+}
+
+func (t *serverAPI) GetAnswerRevisions(w http.ResponseWriter, r *http.Request) {
+	t.getRevisions(w, r, t.service.GetAnswerRevisions)
+}
+
+// getRevisions - общий обработчик истории правок вопроса или ответа.
+func (t *serverAPI) getRevisions(
+	w http.ResponseWriter,
+	r *http.Request,
+	read func(ctx context.Context, id int) (*[]domain.Revision, error),
+) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	id, validationErr := parsePathId(r)
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	revisions, err := read(ctx, id)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*revisions),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/pkg/errors"
+)
+
+func (t *serverAPI) Search(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	searchQuery := domain.SearchQuery{
+		Text:     query.Get("q"),
+		Language: query.Get("lang"),
+	}
+
+	// Валидация входных данных
+	var validationErr string
+	if len(searchQuery.Text) == 0 {
+		validationErr = "query parameter \"q\" must not be empty"
+	}
+	if validationErr == "" {
+		searchQuery.Limit, searchQuery.Offset, validationErr = parseLimitOffset(query)
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	hits, err := t.service.Search(ctx, &searchQuery)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*hits),
+		response.WithMeta(&response.Meta{Limit: searchQuery.Limit}),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
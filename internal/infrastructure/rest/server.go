@@ -1,20 +1,11 @@
 package rest
 
-import (
-	"log/slog"
-)
-
 type QNAServer struct {
 	ServerInstance *Server
 }
 
-func NewApp(
-	log *slog.Logger,
-	addr *string,
-	// tokenSecret *[]byte,
-	service QNADispatcher,
-) *QNAServer {
-	server := NewServer(log, service, addr)
+func NewApp(deps ServerDeps) *QNAServer {
+	server := NewServer(deps)
 	return &QNAServer{
 		ServerInstance: server,
 	}
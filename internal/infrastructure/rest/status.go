@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/pkg/errors"
+)
+
+func (t *serverAPI) AcceptAnswer(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	questionId, validationErr := parsePathId(r)
+	answerId, err := strconv.Atoi(r.PathValue("answerId"))
+	if validationErr == "" && err != nil {
+		validationErr = "invalid ID format for \"answerId\""
+	}
+	if validationErr == "" && answerId < 1 {
+		validationErr = "ID must be a positive integer"
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	question, err := t.service.AcceptAnswer(ctx, questionId, answerId)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*question),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) ChangeQuestionStatus(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.ChangeQuestionStatusRequest
+
+	// Десериализация JSON-запроса
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	questionId, validationErr := parsePathId(r)
+	if validationErr == "" && len(req.Status) == 0 {
+		validationErr = "field \"status\" must not be empty"
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	question, err := t.service.ChangeQuestionStatus(ctx, questionId, domain.QuestionStatus(req.Status))
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*question),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
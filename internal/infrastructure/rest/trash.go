@@ -0,0 +1,180 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (t *serverAPI) GetTrash(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	trashQuery := domain.TrashQuery{Kind: domain.TrashKind(query.Get("kind"))}
+
+	// Валидация входных данных
+	var validationErr string
+	trashQuery.Limit, trashQuery.Offset, validationErr = parseLimitOffset(query)
+	if validationErr == "" && trashQuery.Kind != "" && !trashQuery.Kind.Valid() {
+		validationErr = "\"kind\" must be one of: question, answer, user"
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	items, err := t.service.GetTrash(ctx, &trashQuery)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(*items),
+		response.WithMeta(&response.Meta{Limit: trashQuery.Limit}),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) RestoreQuestion(w http.ResponseWriter, r *http.Request) {
+	t.restore(w, r, t.service.RestoreQuestion)
+}
+
+func (t *serverAPI) RestoreAnswer(w http.ResponseWriter, r *http.Request) {
+	t.restore(w, r, t.service.RestoreAnswer)
+}
+
+// restore - общий обработчик восстановления вопроса или ответа из корзины.
+func (t *serverAPI) restore(
+	w http.ResponseWriter,
+	r *http.Request,
+	restore func(ctx context.Context, id int) error,
+) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	id, validationErr := parsePathId(r)
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	err := restore(ctx, id)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func (t *serverAPI) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	var errorList []error
+	ctx := r.Context()
+
+	userId := r.PathValue("id")
+
+	// Валидация входных данных
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, "invalid UUID format for \"id\""),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	err = t.service.RestoreUser(ctx, &userId)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
@@ -0,0 +1,170 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/middleware"
+	"github.com/pkg/errors"
+)
+
+func (t *serverAPI) VoteQuestion(w http.ResponseWriter, r *http.Request) {
+	t.putVote(w, r, t.service.VoteQuestion)
+}
+
+func (t *serverAPI) RetractQuestionVote(w http.ResponseWriter, r *http.Request) {
+	t.deleteVote(w, r, t.service.RetractQuestionVote)
+}
+
+func (t *serverAPI) VoteAnswer(w http.ResponseWriter, r *http.Request) {
+	t.putVote(w, r, t.service.VoteAnswer)
+}
+
+func (t *serverAPI) RetractAnswerVote(w http.ResponseWriter, r *http.Request) {
+	t.deleteVote(w, r, t.service.RetractAnswerVote)
+}
+
+// putVote - общий обработчик голоса за вопрос или ответ. Повторный запрос
+// с другим значением меняет голос.
+func (t *serverAPI) putVote(
+	w http.ResponseWriter,
+	r *http.Request,
+	vote func(ctx context.Context, id int, value domain.VoteValue) (*domain.VoteResult, error),
+) {
+	var errorList []error
+	ctx := r.Context()
+
+	var req request.VoteRequest
+
+	// Десериализация JSON-запроса
+	err := json.NewDecoder(r.Body).Decode(&req)
+	defer r.Body.Close()
+	if err != nil {
+		errorList = append(errorList, err)
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeJsonParsingFailed, "Invalid request body"),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Валидация входных данных
+	id, validationErr := parsePathId(r)
+	if validationErr == "" && !domain.VoteValue(req.Value).Valid() {
+		validationErr = "field \"value\" must be 1 or -1"
+	}
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	result, err := vote(ctx, id, domain.VoteValue(req.Value))
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(voteResponse(result)),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+// deleteVote - общий обработчик отзыва голоса. Отзыв отсутствующего голоса
+// не считается ошибкой.
+func (t *serverAPI) deleteVote(
+	w http.ResponseWriter,
+	r *http.Request,
+	retract func(ctx context.Context, id int) (*domain.VoteResult, error),
+) {
+	var errorList []error
+	ctx := r.Context()
+
+	// Валидация входных данных
+	id, validationErr := parsePathId(r)
+	if validationErr != "" {
+		errorList = append(errorList, errors.New(validationErr))
+		err := response.ReturnResponse(
+			w,
+			http.StatusBadRequest,
+			response.WithError(response.ErrCodeValidationFailed, validationErr),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, http.StatusBadRequest, &errorList)
+		return
+	}
+
+	// Вызов метода сервиса
+	result, err := retract(ctx, id)
+	if err != nil {
+		errorList = append(errorList, err)
+		status, code, desc := serviceError(err)
+		err := response.ReturnResponse(
+			w,
+			status,
+			response.WithError(code, desc),
+		)
+		if err != nil {
+			errorList = append(errorList, err)
+		}
+		middleware.UpdateContext(ctx, r, status, &errorList)
+		return
+	}
+
+	// Формирование ответа
+	err = response.ReturnResponse(
+		w,
+		http.StatusOK,
+		response.WithData(voteResponse(result)),
+	)
+	if err != nil {
+		errorList = append(errorList, err)
+	}
+	middleware.UpdateContext(ctx, r, http.StatusOK, &errorList)
+}
+
+func voteResponse(result *domain.VoteResult) response.VoteResponse {
+	resp := response.VoteResponse{Score: result.Score}
+	if result.Vote != nil {
+		vote := int(*result.Vote)
+		resp.Vote = &vote
+	}
+	return resp
+}
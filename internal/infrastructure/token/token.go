@@ -0,0 +1,79 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	issuer = "qna"
+)
+
+type accessClaims struct {
+	jwt.RegisteredClaims
+}
+
+// Manager выпускает и проверяет access-токены JWT, подписанные HMAC-SHA256.
+type Manager struct {
+	secret         []byte
+	accessTokenTTL time.Duration
+}
+
+func NewManager(secret []byte, accessTokenTTL time.Duration) *Manager {
+	return &Manager{
+		secret:         secret,
+		accessTokenTTL: accessTokenTTL,
+	}
+}
+
+func (m *Manager) IssueAccessToken(principal *domain.Principal) (accessToken string, expiresAt time.Time, err error) {
+	const op = "internal/infrastructure/token/token.Manager.IssueAccessToken"
+
+	now := time.Now()
+	expiresAt = now.Add(m.accessTokenTTL)
+
+	claims := accessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    issuer,
+			Subject:   principal.UserId,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	accessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, op)
+	}
+
+	return accessToken, expiresAt, nil
+}
+
+func (m *Manager) ParseAccessToken(accessToken string) (*domain.Principal, error) {
+	const op = "internal/infrastructure/token/token.Manager.ParseAccessToken"
+
+	var claims accessClaims
+	_, err := jwt.ParseWithClaims(
+		accessToken,
+		&claims,
+		func(*jwt.Token) (any, error) { return m.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(fmt.Errorf("%w: %w", domain.ErrUnauthorized, err), op)
+	}
+
+	if _, err := uuid.Parse(claims.Subject); err != nil {
+		return nil, errors.Wrap(fmt.Errorf("%w: invalid subject", domain.ErrUnauthorized), op)
+	}
+
+	return &domain.Principal{UserId: claims.Subject}, nil
+}
@@ -1,8 +1,10 @@
 package logpack
 
 import (
+	"context"
 	"fmt"
 	slogzap "github.com/samber/slog-zap/v2"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"log/slog"
@@ -35,5 +37,29 @@ func NewLogger(level string) (*slog.Logger, error) {
 		AddSource: true, // Добавляет информацию об источнике (опционально)
 	}.NewZapHandler()
 
-	return slog.New(handler), nil
+	return slog.New(traceHandler{handler}), nil
+}
+
+// traceHandler добавляет к записям, сделанным с контекстом запроса,
+// идентификаторы трассировки и span, чтобы по записи журнала найти трассировку.
+type traceHandler struct {
+	slog.Handler
+}
+
+func (h traceHandler) Handle(ctx context.Context, record slog.Record) error {
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h traceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return traceHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h traceHandler) WithGroup(name string) slog.Handler {
+	return traceHandler{h.Handler.WithGroup(name)}
 }
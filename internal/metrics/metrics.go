@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "qna"
+
+	// UnmatchedRoute - метка маршрута для запросов, не подошедших ни к одному шаблону,
+	// чтобы произвольные пути не порождали новые временные ряды
+	UnmatchedRoute = "unmatched"
+)
+
+// EntityCounter считает неудаленные сущности для метрик текущего состояния.
+type EntityCounter interface {
+	CountEntities(ctx context.Context) (*domain.EntityTotals, error)
+}
+
+// Registry хранит метрики сервиса и отдает их в текстовом формате Prometheus.
+type Registry struct {
+	registry        *prometheus.Registry
+	httpRequests    *prometheus.CounterVec
+	httpDuration    *prometheus.HistogramVec
+	entitiesCreated *prometheus.CounterVec
+	entitiesDeleted *prometheus.CounterVec
+}
+
+func NewRegistry() *Registry {
+	t := &Registry{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests by method, route pattern and status.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route pattern and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		entitiesCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "entities_created_total",
+			Help:      "Number of created questions, answers and users.",
+		}, []string{"entity"}),
+		entitiesDeleted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "entities_deleted_total",
+			Help:      "Number of deleted questions, answers and users.",
+		}, []string{"entity"}),
+	}
+
+	t.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		t.httpRequests,
+		t.httpDuration,
+		t.entitiesCreated,
+		t.entitiesDeleted,
+	)
+	return t
+}
+
+// RegisterDB добавляет статистику пула соединений db с меткой db_name.
+func (t *Registry) RegisterDB(name string, db *sql.DB) {
+	t.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterEntityTotals добавляет число неудаленных сущностей, которое
+// считается при каждом сборе метрик, но не дольше timeout.
+func (t *Registry) RegisterEntityTotals(counter EntityCounter, timeout time.Duration) {
+	t.registry.MustRegister(&totalsCollector{counter: counter, timeout: timeout})
+}
+
+// ObserveHTTP учитывает завершенный HTTP-запрос.
+func (t *Registry) ObserveHTTP(method string, route string, status int, duration time.Duration) {
+	if route == "" {
+		route = UnmatchedRoute
+	}
+	statusLabel := strconv.Itoa(status)
+	t.httpRequests.WithLabelValues(method, route, statusLabel).Inc()
+	t.httpDuration.WithLabelValues(method, route, statusLabel).Observe(duration.Seconds())
+}
+
+func (t *Registry) RecordCreated(entity string) {
+	t.entitiesCreated.WithLabelValues(entity).Inc()
+}
+
+func (t *Registry) RecordDeleted(entity string) {
+	t.entitiesDeleted.WithLabelValues(entity).Inc()
+}
+
+// Handler отдает метрики в текстовом формате Prometheus.
+func (t *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(t.registry, promhttp.HandlerOpts{})
+}
+
+var entitiesDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "entities"),
+	"Number of not deleted questions, answers and users.",
+	[]string{"entity"}, nil,
+)
+
+type totalsCollector struct {
+	counter EntityCounter
+	timeout time.Duration
+}
+
+func (t *totalsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- entitiesDesc
+}
+
+func (t *totalsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+	defer cancel()
+
+	totals, err := t.counter.CountEntities(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(entitiesDesc, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(entitiesDesc, prometheus.GaugeValue, float64(totals.Questions), "question")
+	ch <- prometheus.MustNewConstMetric(entitiesDesc, prometheus.GaugeValue, float64(totals.Answers), "answer")
+	ch <- prometheus.MustNewConstMetric(entitiesDesc, prometheus.GaugeValue, float64(totals.Users), "user")
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	refreshTokenBytes = 32
+)
+
+type CredentialsManager interface {
+	ReadUserCredentials(ctx context.Context, userName *string) (*domain.Credentials, error)
+	ReadUserCredentialsById(ctx context.Context, userId *string) (*domain.Credentials, error)
+	CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error
+	ReadRefreshToken(ctx context.Context, tokenHash *string) (*domain.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, oldTokenId *string, newToken *domain.RefreshToken) error
+	RevokeRefreshToken(ctx context.Context, tokenId *string) error
+	RevokeUserRefreshTokens(ctx context.Context, userId *string) error
+}
+
+type AccessTokenIssuer interface {
+	IssueAccessToken(principal *domain.Principal) (accessToken string, expiresAt time.Time, err error)
+}
+
+type Auth struct {
+	credentials     CredentialsManager
+	issuer          AccessTokenIssuer
+	refreshTokenTTL time.Duration
+	// хеш для сравнения, когда пользователь не найден, чтобы время ответа
+	// не выдавало существование логина
+	dummyHash []byte
+}
+
+func NewAuthService(credentials CredentialsManager, issuer AccessTokenIssuer, refreshTokenTTL time.Duration) *Auth {
+	dummyHash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return &Auth{
+		credentials:     credentials,
+		issuer:          issuer,
+		refreshTokenTTL: refreshTokenTTL,
+		dummyHash:       dummyHash,
+	}
+}
+
+func (t *Auth) Login(ctx context.Context, userName *string, password *string) (*domain.TokenPair, error) {
+	const op = "internal/usecase/auth.Auth.Login"
+
+	credentials, err := t.credentials.ReadUserCredentials(ctx, userName)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if credentials == nil || credentials.PasswordHash == "" {
+		_ = bcrypt.CompareHashAndPassword(t.dummyHash, []byte(*password))
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "invalid name or password"), op)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(credentials.PasswordHash), []byte(*password)); err != nil {
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "invalid name or password"), op)
+	}
+
+	refreshToken, refreshTokenRaw, err := t.newRefreshToken(credentials.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if err := t.credentials.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	tokens, err := t.tokenPair(credentials, refreshToken, refreshTokenRaw)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return tokens, nil
+}
+
+// Refresh обменивает refresh-токен на новую пару токенов. Предъявленный токен
+// отзывается; повторное предъявление отозванного токена считается утечкой
+// и отзывает все токены пользователя.
+func (t *Auth) Refresh(ctx context.Context, refreshTokenRaw *string) (*domain.TokenPair, error) {
+	const op = "internal/usecase/auth.Auth.Refresh"
+
+	tokenHash := hashRefreshToken(*refreshTokenRaw)
+	current, err := t.credentials.ReadRefreshToken(ctx, &tokenHash)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "invalid refresh token"), op)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if current.RevokedAt != nil {
+		if err := t.credentials.RevokeUserRefreshTokens(ctx, &current.UserId); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "refresh token has been revoked"), op)
+	}
+	if time.Now().After(current.ExpiresAt) {
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "refresh token has expired"), op)
+	}
+
+	credentials, err := t.credentials.ReadUserCredentialsById(ctx, &current.UserId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "invalid refresh token"), op)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	next, nextRaw, err := t.newRefreshToken(current.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	// токен мог быть использован параллельным запросом
+	err = t.credentials.RotateRefreshToken(ctx, &current.Id, next)
+	if errors.Is(err, domain.ErrConflict) {
+		if err := t.credentials.RevokeUserRefreshTokens(ctx, &current.UserId); err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		return nil, errors.Wrap(domain.NewError(domain.ErrUnauthorized, "refresh token has been revoked"), op)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	tokens, err := t.tokenPair(credentials, next, nextRaw)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return tokens, nil
+}
+
+func (t *Auth) Logout(ctx context.Context, refreshTokenRaw *string) error {
+	const op = "internal/usecase/auth.Auth.Logout"
+
+	tokenHash := hashRefreshToken(*refreshTokenRaw)
+	current, err := t.credentials.ReadRefreshToken(ctx, &tokenHash)
+	if errors.Is(err, domain.ErrNotFound) {
+		return errors.Wrap(domain.NewError(domain.ErrUnauthorized, "invalid refresh token"), op)
+	}
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if current.RevokedAt != nil {
+		return nil
+	}
+
+	if err := t.credentials.RevokeRefreshToken(ctx, &current.Id); err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
+
+func (t *Auth) newRefreshToken(userId string) (*domain.RefreshToken, string, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(raw)
+
+	return &domain.RefreshToken{
+		UserId:    userId,
+		TokenHash: hashRefreshToken(encoded),
+		ExpiresAt: time.Now().Add(t.refreshTokenTTL),
+	}, encoded, nil
+}
+
+func (t *Auth) tokenPair(credentials *domain.Credentials, refreshToken *domain.RefreshToken, refreshTokenRaw string) (*domain.TokenPair, error) {
+	accessToken, accessExpiresAt, err := t.issuer.IssueAccessToken(&domain.Principal{UserId: credentials.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshTokenRaw,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, nil
+}
+
+// в базе хранится только хеш refresh-токена
+func hashRefreshToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
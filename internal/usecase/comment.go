@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (t *QNACrud) CreateComment(ctx context.Context, comment *domain.Comment) (commentId int, err error) {
+	const op = "internal/usecase/comment.QNACrud.CreateComment"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	// автором комментария всегда является аутентифицированный пользователь
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return 0, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+	comment.UserId = principal.UserId
+
+	comment.Text = strings.TrimSpace(comment.Text)
+	length := utf8.RuneCountInString(comment.Text)
+	if length < domain.MinCommentLength || length > domain.MaxCommentLength {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "comment length must be between 2 and 600 characters"), op)
+	}
+
+	question, err := t.commentedQuestion(ctx, comment.Parent, comment.ParentId)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	if question.Status == domain.QuestionStatusLocked {
+		return 0, errors.Wrap(domain.NewError(domain.ErrConflict, "question is locked"), op)
+	}
+
+	commentId, err = t.qnaManager.CreateComment(ctx, comment)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	return commentId, nil
+}
+
+func (t *QNACrud) GetComments(ctx context.Context, parent domain.CommentParent, parentId int) (*[]domain.Comment, error) {
+	const op = "internal/usecase/comment.QNACrud.GetComments"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if _, err := t.commentedQuestion(ctx, parent, parentId); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	comments, err := t.qnaManager.ReadComments(ctx, parent, parentId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return comments, nil
+}
+
+// GetThreadComments возвращает комментарии к вопросу и всем его ответам.
+func (t *QNACrud) GetThreadComments(ctx context.Context, questionId int) (*[]domain.Comment, error) {
+	const op = "internal/usecase/comment.QNACrud.GetThreadComments"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	comments, err := t.qnaManager.ReadThreadComments(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return comments, nil
+}
+
+// DeleteComment удаляет комментарий публикации parent/parentId; комментарий
+// другой публикации считается не найденным.
+func (t *QNACrud) DeleteComment(ctx context.Context, parent domain.CommentParent, parentId int, commentId int) error {
+	const op = "internal/usecase/comment.QNACrud.DeleteComment"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	comment, err := t.qnaManager.ReadComment(ctx, commentId)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if comment.Parent != parent || comment.ParentId != parentId {
+		return errors.Wrap(domain.ErrNotFound, op)
+	}
+
+	err = t.policy.AuthorizeOwner(ctx, comment.UserId, domain.PermissionCommentDeleteOwn, domain.PermissionCommentDeleteAny)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	err = t.qnaManager.DeleteComment(ctx, commentId)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
+
+// commentedQuestion возвращает вопрос, к которому относится комментируемая публикация.
+func (t *QNACrud) commentedQuestion(ctx context.Context, parent domain.CommentParent, parentId int) (*domain.Question, error) {
+	switch parent {
+	case domain.CommentParentQuestion:
+		return t.qnaManager.ReadQuestion(ctx, parentId)
+	case domain.CommentParentAnswer:
+		answer, err := t.qnaManager.ReadAnswer(ctx, parentId)
+		if err != nil {
+			return nil, err
+		}
+		return t.qnaManager.ReadQuestion(ctx, answer.QuestionId)
+	default:
+		return nil, domain.NewError(domain.ErrValidation, "unknown comment parent")
+	}
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+)
+
+const (
+	// subscriberBuffer - сколько событий может накопить медленный подписчик,
+	// прежде чем его подписка будет закрыта
+	subscriberBuffer = 64
+)
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event)
+}
+
+// EventBus доставляет доменные события всем экземплярам сервиса. Ошибки
+// публикации не возвращаются: запись уже выполнена, реализация сама их журналирует.
+type EventBus interface {
+	EventPublisher
+	// Listen передает handler события всех экземпляров, пока не отменен ctx.
+	Listen(ctx context.Context, handler func(domain.Event)) error
+}
+
+// publish отправляет доменное событие, если сервису передан издатель.
+func (t *QNACrud) publish(ctx context.Context, event domain.Event) {
+	if t.events == nil {
+		return
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	t.events.Publish(ctx, event)
+}
+
+// EventBroker рассылает события подписчикам внутри процесса и хранит
+// ограниченное число последних событий для возобновления потока по их Id.
+type EventBroker struct {
+	mu          sync.Mutex
+	lastId      int64
+	replay      []domain.Event
+	replayStart int
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	events chan domain.Event
+	match  func(domain.Event) bool
+}
+
+// NewEventBroker создает брокер, хранящий до replaySize последних событий;
+// при replaySize <= 0 события не сохраняются и возобновление недоступно.
+func NewEventBroker(replaySize int) *EventBroker {
+	return &EventBroker{
+		replay:      make([]domain.Event, 0, max(replaySize, 0)),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish сохраняет событие в буфере и рассылает подходящим подписчикам.
+// Событию без Id назначается следующий номер; Id, назначенный шиной, сохраняется.
+func (t *EventBroker) Publish(ctx context.Context, event domain.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if event.Id == 0 {
+		event.Id = t.lastId + 1
+	}
+	t.lastId = max(t.lastId, event.Id)
+
+	if len(t.replay) < cap(t.replay) {
+		t.replay = append(t.replay, event)
+	} else if cap(t.replay) > 0 {
+		t.replay[t.replayStart] = event
+		t.replayStart = (t.replayStart + 1) % cap(t.replay)
+	}
+
+	for sub := range t.subscribers {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// подписчик отстал: закрываем поток, клиент переподключится с Last-Event-ID
+			t.unsubscribe(sub)
+		}
+	}
+}
+
+// Subscribe возвращает пропущенные события из буфера и канал новых событий.
+// match отбирает события; nil - все события. Канал закрывается вызовом cancel
+// или если подписчик не успевает забирать события.
+//
+// Шина может доставлять события не по возрастанию Id (Postgres назначает Id до
+// фиксации транзакции), но во всех экземплярах в одном порядке. Поэтому если
+// событие afterId есть в буфере, возвращаются все события, полученные после
+// него. Иначе (afterId == 0 или событие вытеснено из буфера) возвращаются
+// события с Id больше afterId, и события, пришедшие с меньшим Id позже,
+// могут быть пропущены.
+func (t *EventBroker) Subscribe(afterId int64, match func(domain.Event) bool) (missed []domain.Event, events <-chan domain.Event, cancel func()) {
+	if match == nil {
+		match = func(domain.Event) bool { return true }
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	buffered := make([]domain.Event, 0, len(t.replay))
+	for i := range t.replay {
+		buffered = append(buffered, t.replay[(t.replayStart+i)%len(t.replay)])
+	}
+
+	if i := slices.IndexFunc(buffered, func(event domain.Event) bool { return event.Id == afterId }); afterId > 0 && i >= 0 {
+		buffered = buffered[i+1:]
+	} else {
+		buffered = slices.DeleteFunc(buffered, func(event domain.Event) bool { return event.Id <= afterId })
+	}
+	for _, event := range buffered {
+		if match(event) {
+			missed = append(missed, event)
+		}
+	}
+
+	sub := &subscriber{
+		events: make(chan domain.Event, subscriberBuffer),
+		match:  match,
+	}
+	t.subscribers[sub] = struct{}{}
+
+	cancel = func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.unsubscribe(sub)
+	}
+	return missed, sub.events, cancel
+}
+
+func (t *EventBroker) unsubscribe(sub *subscriber) {
+	if _, ok := t.subscribers[sub]; !ok {
+		return
+	}
+	delete(t.subscribers, sub)
+	close(sub.events)
+}
+
+// MemoryEventBus - шина событий внутри одного процесса: для тестов и запуска
+// сервиса в одном экземпляре.
+type MemoryEventBus struct {
+	mu          sync.Mutex
+	lastId      int64
+	nextHandler int
+	handlers    map[int]func(domain.Event)
+}
+
+func NewMemoryEventBus() *MemoryEventBus {
+	return &MemoryEventBus{
+		handlers: make(map[int]func(domain.Event)),
+	}
+}
+
+// Publish назначает событию следующий Id и синхронно передает его слушателям.
+func (t *MemoryEventBus) Publish(ctx context.Context, event domain.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastId++
+	event.Id = t.lastId
+	for _, handler := range t.handlers {
+		handler(event)
+	}
+}
+
+func (t *MemoryEventBus) Listen(ctx context.Context, handler func(domain.Event)) error {
+	t.mu.Lock()
+	key := t.nextHandler
+	t.nextHandler++
+	t.handlers[key] = handler
+	t.mu.Unlock()
+
+	<-ctx.Done()
+
+	t.mu.Lock()
+	delete(t.handlers, key)
+	t.mu.Unlock()
+	return nil
+}
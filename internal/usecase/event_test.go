@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eventIds(events []domain.Event) []int64 {
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.Id)
+	}
+	return ids
+}
+
+func TestEventBrokerReplay(t *testing.T) {
+	broker := NewEventBroker(3)
+	for questionId := 1; questionId <= 5; questionId++ {
+		broker.Publish(context.Background(), domain.Event{Type: domain.EventQuestionCreated, QuestionId: questionId})
+	}
+
+	// в буфере остаются только три последних события
+	missed, _, cancel := broker.Subscribe(0, nil)
+	cancel()
+	assert.Equal(t, []int64{3, 4, 5}, eventIds(missed))
+
+	missed, _, cancel = broker.Subscribe(4, nil)
+	cancel()
+	assert.Equal(t, []int64{5}, eventIds(missed))
+
+	missed, _, cancel = broker.Subscribe(0, func(event domain.Event) bool { return event.QuestionId == 4 })
+	cancel()
+	assert.Equal(t, []int64{4}, eventIds(missed))
+}
+
+func TestEventBrokerReplayOutOfOrder(t *testing.T) {
+	broker := NewEventBroker(10)
+	// шина Postgres может доставить событие с меньшим Id позже
+	for _, id := range []int64{1, 3, 2, 4} {
+		broker.Publish(context.Background(), domain.Event{Id: id, Type: domain.EventQuestionCreated})
+	}
+
+	missed, _, cancel := broker.Subscribe(3, nil)
+	cancel()
+	assert.Equal(t, []int64{2, 4}, eventIds(missed))
+
+	// события afterId нет в буфере: возобновление по Id
+	missed, _, cancel = broker.Subscribe(5, nil)
+	cancel()
+	assert.Empty(t, missed)
+}
+
+func TestEventBrokerWithoutReplay(t *testing.T) {
+	for _, replaySize := range []int{0, -1} {
+		broker := NewEventBroker(replaySize)
+		broker.Publish(context.Background(), domain.Event{Type: domain.EventQuestionCreated, QuestionId: 1})
+
+		missed, _, cancel := broker.Subscribe(0, nil)
+		cancel()
+		assert.Empty(t, missed)
+	}
+}
+
+func TestEventBrokerSubscribe(t *testing.T) {
+	broker := NewEventBroker(10)
+
+	_, events, cancel := broker.Subscribe(0, func(event domain.Event) bool { return event.QuestionId == 1 })
+	defer cancel()
+
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventQuestionCreated, QuestionId: 2})
+	broker.Publish(context.Background(), domain.Event{Type: domain.EventAnswerCreated, QuestionId: 1, AnswerId: 7})
+
+	event := <-events
+	assert.Equal(t, int64(2), event.Id)
+	assert.Equal(t, domain.EventAnswerCreated, event.Type)
+	assert.Equal(t, 7, event.AnswerId)
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestEventBrokerSlowSubscriber(t *testing.T) {
+	broker := NewEventBroker(0)
+
+	_, events, cancel := broker.Subscribe(0, nil)
+	defer cancel()
+
+	for range subscriberBuffer + 1 {
+		broker.Publish(context.Background(), domain.Event{Type: domain.EventQuestionCreated, QuestionId: 1})
+	}
+
+	// отставший подписчик получает накопленные события, после чего канал закрыт
+	received := 0
+	for range events {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received)
+}
+
+// answerStub принимает ответы к вопросу из questionStub.
+type answerStub struct {
+	questionStub
+	nextAnswerId int
+}
+
+func (t *answerStub) CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (int, error) {
+	t.nextAnswerId++
+	return t.nextAnswerId, nil
+}
+
+func TestCreateAnswerPublishesEvent(t *testing.T) {
+	const author = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+
+	bus := NewMemoryEventBus()
+	broker := NewEventBroker(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = bus.Listen(ctx, func(event domain.Event) { broker.Publish(ctx, event) })
+	}()
+	require.Eventually(t, func() bool {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+		return len(bus.handlers) == 1
+	}, time.Second, time.Millisecond)
+
+	_, events, unsubscribe := broker.Subscribe(0, nil)
+	defer unsubscribe()
+
+	stub := &answerStub{questionStub: questionStub{question: domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusOpen}}}
+	service := NewQNAManagerService(QNADeps{QNAManager: stub, Policy: NewPolicy(permissionsStub{}), UserDeletion: domain.UserDeletionCascade, Events: bus})
+	principalCtx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{UserId: author})
+
+	for _, expectedId := range []int64{1, 2} {
+		answerId, err := service.CreateAnswerToQuestion(principalCtx, &domain.Answer{QuestionId: 1, Text: "text"})
+		require.NoError(t, err)
+
+		// номер события назначает шина, брокер его сохраняет
+		event := <-events
+		assert.Equal(t, expectedId, event.Id)
+		assert.Equal(t, domain.EventAnswerCreated, event.Type)
+		assert.Equal(t, 1, event.QuestionId)
+		assert.Equal(t, answerId, event.AnswerId)
+		assert.False(t, event.OccurredAt.IsZero())
+	}
+
+	// после отмены ctx слушатель отписывается от шины
+	cancel()
+	require.Eventually(t, func() bool {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+		return len(bus.handlers) == 0
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,25 @@
+package usecase
+
+const (
+	entityQuestion = "question"
+	entityAnswer   = "answer"
+	entityUser     = "user"
+)
+
+// MetricsRecorder учитывает бизнес-операции для метрик сервиса.
+type MetricsRecorder interface {
+	RecordCreated(entity string)
+	RecordDeleted(entity string)
+}
+
+func (t *QNACrud) recordCreated(entity string) {
+	if t.metrics != nil {
+		t.metrics.RecordCreated(entity)
+	}
+}
+
+func (t *QNACrud) recordDeleted(entity string) {
+	if t.metrics != nil {
+		t.metrics.RecordDeleted(entity)
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+type PermissionManager interface {
+	HasPermission(ctx context.Context, userId *string, permission domain.Permission) (bool, error)
+}
+
+// Policy решает, может ли аутентифицированный пользователь выполнить операцию.
+// Права проверяются по текущей роли пользователя в базе, поэтому смена роли
+// действует сразу, а не после перевыпуска токена.
+type Policy struct {
+	permissions PermissionManager
+}
+
+func NewPolicy(permissions PermissionManager) *Policy {
+	return &Policy{
+		permissions: permissions,
+	}
+}
+
+func (t *Policy) Authorize(ctx context.Context, permission domain.Permission) error {
+	const op = "internal/usecase/policy.Policy.Authorize"
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return errors.Wrap(domain.ErrUnauthorized, op)
+	}
+
+	allowed, err := t.permissions.HasPermission(ctx, &principal.UserId, permission)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if !allowed {
+		return errors.Wrap(domain.NewError(domain.ErrForbidden, "not enough permissions"), op)
+	}
+	return nil
+}
+
+// AuthorizeOwner разрешает операцию владельцу сущности с правом ownPermission
+// либо любому пользователю с правом anyPermission.
+func (t *Policy) AuthorizeOwner(ctx context.Context, ownerId string, ownPermission, anyPermission domain.Permission) error {
+	const op = "internal/usecase/policy.Policy.AuthorizeOwner"
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return errors.Wrap(domain.ErrUnauthorized, op)
+	}
+
+	permission := anyPermission
+	if principal.UserId == ownerId {
+		permission = ownPermission
+	}
+
+	if err := t.Authorize(ctx, permission); err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
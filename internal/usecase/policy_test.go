@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+type permissionsStub map[string][]domain.Permission
+
+func (t permissionsStub) HasPermission(ctx context.Context, userId *string, permission domain.Permission) (bool, error) {
+	for _, p := range t[*userId] {
+		if p == permission {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestPolicyAuthorizeOwner(t *testing.T) {
+	const (
+		author    = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+		stranger  = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+		moderator = "9b2f7e8a-1f4e-4c1a-9d8e-2b1e2f3a4b5c"
+	)
+
+	policy := NewPolicy(permissionsStub{
+		author:    {domain.PermissionAnswerDeleteOwn},
+		stranger:  {domain.PermissionAnswerDeleteOwn},
+		moderator: {domain.PermissionAnswerDeleteOwn, domain.PermissionAnswerDeleteAny},
+	})
+
+	testCases := []struct {
+		name        string
+		principal   *domain.Principal
+		expectedErr error
+	}{
+		{name: "author", principal: &domain.Principal{UserId: author}},
+		{name: "moderator", principal: &domain.Principal{UserId: moderator}},
+		{name: "stranger", principal: &domain.Principal{UserId: stranger}, expectedErr: domain.ErrForbidden},
+		{name: "anonymous", expectedErr: domain.ErrUnauthorized},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = domain.ContextWithPrincipal(ctx, tt.principal)
+			}
+
+			err := policy.AuthorizeOwner(ctx, author, domain.PermissionAnswerDeleteOwn, domain.PermissionAnswerDeleteAny)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
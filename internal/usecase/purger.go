@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type TrashPurger interface {
+	PurgeDeleted(ctx context.Context, before time.Time) (purged int64, err error)
+}
+
+// Purger периодически окончательно удаляет записи, пролежавшие в корзине
+// дольше retention.
+type Purger struct {
+	trash     TrashPurger
+	retention time.Duration
+	interval  time.Duration
+	log       *slog.Logger
+	now       func() time.Time
+}
+
+func NewPurger(trash TrashPurger, retention time.Duration, interval time.Duration, log *slog.Logger) *Purger {
+	return &Purger{
+		trash:     trash,
+		retention: retention,
+		interval:  interval,
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// Run очищает корзину сразу и затем раз в interval, пока не отменен ctx.
+func (t *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := t.Purge(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			t.log.Error("failed to purge trash", slog.String("error", err.Error()))
+		} else if purged > 0 {
+			t.log.Info("trash purged", slog.Int64("purged", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge выполняет один проход очистки и возвращает число удаленных записей.
+func (t *Purger) Purge(ctx context.Context) (purged int64, err error) {
+	const op = "internal/usecase/purger.Purger.Purge"
+
+	purged, err = t.trash.PurgeDeleted(ctx, t.now().Add(-t.retention))
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	return purged, nil
+}
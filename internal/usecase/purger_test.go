@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// purgerStub запоминает границы очистки, переданные репозиторию.
+type purgerStub struct {
+	calls chan time.Time
+}
+
+func (t *purgerStub) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	t.calls <- before
+	return 1, nil
+}
+
+func TestPurger(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	stub := &purgerStub{calls: make(chan time.Time, 8)}
+
+	purger := NewPurger(stub, 72*time.Hour, time.Millisecond, slog.Default())
+	purger.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		purger.Run(ctx)
+	}()
+
+	// первый проход выполняется сразу, следующие - по таймеру
+	for range 2 {
+		select {
+		case before := <-stub.calls:
+			assert.Equal(t, now.Add(-72*time.Hour), before)
+		case <-time.After(time.Second):
+			require.FailNow(t, "purge was not called")
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.FailNow(t, "purger did not stop after cancel")
+	}
+}
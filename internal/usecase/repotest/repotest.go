@@ -0,0 +1,718 @@
+// Package repotest - общий набор контрактных тестов хранилищ usecase.QNAManager,
+// usecase.UserManager и usecase.CredentialsManager. Его проходят db.Repository
+// и memory.Repository.
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Repository - хранилище, которое проверяет набор.
+type Repository interface {
+	usecase.QNAManager
+	usecase.UserManager
+	usecase.CredentialsManager
+}
+
+// unknownUserId - корректный UUID, которого нет в хранилище.
+const unknownUserId = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+
+// missingId - id вопроса, ответа или комментария, которого нет в хранилище.
+const missingId = 1_000_000
+
+// Run запускает контрактные тесты. newRepository вызывается перед каждым тестом
+// и возвращает хранилище, в котором есть только служебный пользователь
+// domain.DeletedUserId.
+func Run(t *testing.T, newRepository func(t *testing.T) Repository) {
+	testCases := []struct {
+		name string
+		test func(t *testing.T, repo Repository)
+	}{
+		{name: "Users", test: testUsers},
+		{name: "Credentials", test: testCredentials},
+		{name: "RefreshTokens", test: testRefreshTokens},
+		{name: "Questions", test: testQuestions},
+		{name: "QuestionList", test: testQuestionList},
+		{name: "Answers", test: testAnswers},
+		{name: "DeleteQuestion", test: testDeleteQuestion},
+		{name: "Revisions", test: testRevisions},
+		{name: "Votes", test: testVotes},
+		{name: "Status", test: testStatus},
+		{name: "Tags", test: testTags},
+		{name: "Comments", test: testComments},
+		{name: "DeleteUserCascade", test: testDeleteUserCascade},
+		{name: "DeleteUserAnonymize", test: testDeleteUserAnonymize},
+		{name: "DeleteUserBlock", test: testDeleteUserBlock},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepository(t))
+		})
+	}
+}
+
+func testUsers(t *testing.T, repo Repository) {
+	ctx := context.Background()
+
+	alice := createUser(t, repo, "alice")
+	_, err := uuid.Parse(alice)
+	assert.NoError(t, err)
+
+	name := "alice"
+	_, err = repo.CreateUser(ctx, &name, nil)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	// имя заглушки занято
+	name = "deleted user"
+	_, err = repo.CreateUser(ctx, &name, nil)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	newName := "alice2"
+	user, err := repo.UpdateUser(ctx, &alice, &domain.UserPatch{Name: &newName})
+	require.NoError(t, err)
+	assert.Equal(t, domain.User{Id: alice, Name: newName, Role: domain.RoleUser}, *user)
+
+	unknown := unknownUserId
+	_, err = repo.UpdateUser(ctx, &unknown, &domain.UserPatch{Name: &newName})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	bob := createUser(t, repo, "bob")
+
+	// страницы по одной записи возвращают каждого пользователя ровно один раз
+	var ids []string
+	params := &domain.ListParams{Limit: 1, Order: domain.SortAsc}
+	for {
+		users, page, err := repo.ReadUsers(ctx, params)
+		require.NoError(t, err)
+		for _, user := range *users {
+			ids = append(ids, user.Id)
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		params.After = page.NextCursor
+	}
+	// служебный пользователь-заглушка в список не попадает
+	assert.ElementsMatch(t, []string{alice, bob}, ids)
+
+	_, _, err = repo.ReadUsers(ctx, &domain.ListParams{Limit: 1, After: &domain.Cursor{Id: "abc"}})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func testCredentials(t *testing.T, repo Repository) {
+	ctx := context.Background()
+
+	name, hash := "alice", "$2a$10$hash"
+	userId, err := repo.CreateUser(ctx, &name, &hash)
+	require.NoError(t, err)
+
+	credentials, err := repo.ReadUserCredentials(ctx, &name)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Credentials{UserId: *userId, Name: name, PasswordHash: hash}, *credentials)
+
+	credentials, err = repo.ReadUserCredentialsById(ctx, userId)
+	require.NoError(t, err)
+	assert.Equal(t, hash, credentials.PasswordHash)
+
+	// у пользователя без пароля хеш пустой
+	bob := createUser(t, repo, "bob")
+	credentials, err = repo.ReadUserCredentialsById(ctx, &bob)
+	require.NoError(t, err)
+	assert.Empty(t, credentials.PasswordHash)
+
+	// смена пароля отзывает выданные refresh-токены
+	token := domain.RefreshToken{UserId: bob, TokenHash: "bob", ExpiresAt: time.Now().Add(time.Hour)}
+	require.NoError(t, repo.CreateRefreshToken(ctx, &token))
+	newHash := "$2a$10$new"
+	_, err = repo.UpdateUser(ctx, &bob, &domain.UserPatch{PasswordHash: &newHash})
+	require.NoError(t, err)
+	credentials, err = repo.ReadUserCredentialsById(ctx, &bob)
+	require.NoError(t, err)
+	assert.Equal(t, newHash, credentials.PasswordHash)
+	revoked, err := repo.ReadRefreshToken(ctx, &token.TokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	unknownName := "nobody"
+	_, err = repo.ReadUserCredentials(ctx, &unknownName)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	unknown := unknownUserId
+	_, err = repo.ReadUserCredentialsById(ctx, &unknown)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	// пользователь в корзине не может войти
+	_, err = repo.DeleteUser(ctx, userId, domain.UserDeletionCascade)
+	require.NoError(t, err)
+	_, err = repo.ReadUserCredentials(ctx, &name)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.ReadUserCredentialsById(ctx, userId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testRefreshTokens(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+	alice := createUser(t, repo, "alice")
+	first := domain.RefreshToken{UserId: alice, TokenHash: "first", ExpiresAt: expiresAt}
+	require.NoError(t, repo.CreateRefreshToken(ctx, &first))
+	assert.NotEmpty(t, first.Id)
+
+	duplicate := domain.RefreshToken{UserId: alice, TokenHash: "first", ExpiresAt: expiresAt}
+	assert.ErrorIs(t, repo.CreateRefreshToken(ctx, &duplicate), domain.ErrConflict)
+	orphan := domain.RefreshToken{UserId: unknownUserId, TokenHash: "orphan", ExpiresAt: expiresAt}
+	assert.ErrorIs(t, repo.CreateRefreshToken(ctx, &orphan), domain.ErrReferenceNotFound)
+
+	hash := "first"
+	token, err := repo.ReadRefreshToken(ctx, &hash)
+	require.NoError(t, err)
+	assert.Equal(t, first.Id, token.Id)
+	assert.Equal(t, alice, token.UserId)
+	assert.True(t, expiresAt.Equal(token.ExpiresAt))
+	assert.Nil(t, token.RevokedAt)
+
+	missing := "missing"
+	_, err = repo.ReadRefreshToken(ctx, &missing)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	// ротация отзывает старый токен; повторная ротация - конфликт
+	second := domain.RefreshToken{UserId: alice, TokenHash: "second", ExpiresAt: expiresAt}
+	require.NoError(t, repo.RotateRefreshToken(ctx, &first.Id, &second))
+	assert.NotEmpty(t, second.Id)
+	token, err = repo.ReadRefreshToken(ctx, &hash)
+	require.NoError(t, err)
+	assert.NotNil(t, token.RevokedAt)
+
+	third := domain.RefreshToken{UserId: alice, TokenHash: "third", ExpiresAt: expiresAt}
+	assert.ErrorIs(t, repo.RotateRefreshToken(ctx, &first.Id, &third), domain.ErrConflict)
+	_, err = repo.ReadRefreshToken(ctx, &third.TokenHash)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, repo.RevokeRefreshToken(ctx, &second.Id))
+	token, err = repo.ReadRefreshToken(ctx, &second.TokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, token.RevokedAt)
+
+	fourth := domain.RefreshToken{UserId: alice, TokenHash: "fourth", ExpiresAt: expiresAt}
+	require.NoError(t, repo.CreateRefreshToken(ctx, &fourth))
+	require.NoError(t, repo.RevokeUserRefreshTokens(ctx, &alice))
+	token, err = repo.ReadRefreshToken(ctx, &fourth.TokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, token.RevokedAt)
+}
+
+func testQuestions(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+
+	first := createQuestion(t, repo, author, "first", "sql", "go")
+	second := createQuestion(t, repo, author, "second")
+	assert.Greater(t, second, first)
+
+	question, err := repo.ReadQuestion(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, first, question.Id)
+	assert.Equal(t, author, question.UserId)
+	assert.Equal(t, "first", question.Title)
+	assert.Equal(t, "text of first", question.Text)
+	assert.Equal(t, []string{"go", "sql"}, question.Tags)
+	assert.Equal(t, domain.QuestionStatusOpen, question.Status)
+	assert.Nil(t, question.AcceptedAnswerId)
+	assert.Zero(t, question.Score)
+	assert.False(t, question.CreatedAt.IsZero())
+
+	_, err = repo.CreateQuestion(ctx, &domain.Question{UserId: unknownUserId, Title: "title"})
+	assert.ErrorIs(t, err, domain.ErrReferenceNotFound)
+	_, err = repo.CreateQuestion(ctx, &domain.Question{UserId: "not-a-uuid", Title: "title"})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = repo.ReadQuestion(ctx, missingId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	title := "updated"
+	question, err = repo.UpdateQuestion(ctx, first, &domain.QuestionPatch{Title: &title, Tags: &[]string{}}, author)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", question.Title)
+	assert.Empty(t, question.Tags)
+
+	_, err = repo.UpdateQuestion(ctx, missingId, &domain.QuestionPatch{Title: &title}, author)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testQuestionList(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+
+	goOnly := createQuestion(t, repo, author, "go only", "go")
+	both := createQuestion(t, repo, author, "both", "go", "sql")
+	sqlOnly := createQuestion(t, repo, author, "sql only", "sql")
+	_, err := repo.UpdateQuestionStatus(ctx, both, domain.QuestionStatusOpen, domain.QuestionStatusClosed)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		filter   domain.QuestionFilter
+		expected []int
+	}{
+		{name: "No filter", expected: []int{goOnly, both, sqlOnly}},
+		{name: "All tags", filter: domain.QuestionFilter{Tags: []string{"go", "sql"}}, expected: []int{both}},
+		{
+			name:     "Any tag",
+			filter:   domain.QuestionFilter{Tags: []string{"go", "sql"}, TagMatch: domain.TagMatchAny},
+			expected: []int{goOnly, both, sqlOnly},
+		},
+		{name: "Status", filter: domain.QuestionFilter{Statuses: []domain.QuestionStatus{domain.QuestionStatusClosed}}, expected: []int{both}},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			questions, _, err := repo.ReadQuestions(ctx, &domain.ListParams{Limit: domain.MaxPageLimit}, &tt.filter)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, questionIds(*questions))
+		})
+	}
+
+	// постраничное чтение совпадает с чтением одной страницей
+	all, page, err := repo.ReadQuestions(ctx, &domain.ListParams{Limit: domain.MaxPageLimit}, &domain.QuestionFilter{})
+	require.NoError(t, err)
+	assert.Nil(t, page.NextCursor)
+
+	var ids []int
+	params := &domain.ListParams{Limit: 1}
+	for {
+		questions, page, err := repo.ReadQuestions(ctx, params, &domain.QuestionFilter{})
+		require.NoError(t, err)
+		ids = append(ids, questionIds(*questions)...)
+		if page.NextCursor == nil {
+			break
+		}
+		params.After = page.NextCursor
+	}
+	assert.Equal(t, questionIds(*all), ids)
+
+	future := time.Now().Add(time.Hour)
+	questions, _, err := repo.ReadQuestions(ctx, &domain.ListParams{Limit: 1, CreatedAfter: &future}, &domain.QuestionFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, *questions)
+
+	_, _, err = repo.ReadQuestions(ctx, &domain.ListParams{Limit: 1, After: &domain.Cursor{Id: "abc"}}, &domain.QuestionFilter{})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func testAnswers(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	other := createUser(t, repo, "other")
+	questionId := createQuestion(t, repo, author, "question")
+
+	first := createAnswer(t, repo, questionId, author)
+	second := createAnswer(t, repo, questionId, other)
+
+	answer, err := repo.ReadAnswer(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, domain.Answer{Id: first, QuestionId: questionId, UserId: author, Text: "answer"}, *answer)
+
+	// ответы упорядочены по рейтингу
+	_, err = repo.VoteAnswer(ctx, second, author, domain.VoteUp)
+	require.NoError(t, err)
+	question, answers, err := repo.ReadQuestionAndAnswers(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, questionId, question.Id)
+	assert.Equal(t, []int{second, first}, answerIds(*answers))
+
+	_, err = repo.CreateAnswerToQuestion(ctx, &domain.Answer{QuestionId: missingId, UserId: author, Text: "answer"})
+	assert.ErrorIs(t, err, domain.ErrReferenceNotFound)
+	_, err = repo.CreateAnswerToQuestion(ctx, &domain.Answer{QuestionId: questionId, UserId: unknownUserId, Text: "answer"})
+	assert.ErrorIs(t, err, domain.ErrReferenceNotFound)
+
+	require.NoError(t, repo.DeleteAnswer(ctx, first))
+	_, err = repo.ReadAnswer(ctx, first)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, repo.DeleteAnswer(ctx, first), domain.ErrNotFound)
+
+	_, answers, err = repo.ReadQuestionAndAnswers(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, []int{second}, answerIds(*answers))
+
+	_, _, err = repo.ReadQuestionAndAnswers(ctx, missingId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testDeleteQuestion(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	questionId := createQuestion(t, repo, author, "question", "go")
+	answerId := createAnswer(t, repo, questionId, author)
+
+	require.NoError(t, repo.DeleteQuestionAndAnswers(ctx, questionId))
+
+	_, err := repo.ReadQuestion(ctx, questionId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.ReadAnswer(ctx, answerId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, repo.DeleteQuestionAndAnswers(ctx, questionId), domain.ErrNotFound)
+	// на вопрос в корзине ответить нельзя
+	_, err = repo.CreateAnswerToQuestion(ctx, &domain.Answer{QuestionId: questionId, UserId: author, Text: "answer"})
+	assert.ErrorIs(t, err, domain.ErrReferenceNotFound)
+
+	questions, _, err := repo.ReadQuestions(ctx, &domain.ListParams{Limit: domain.MaxPageLimit}, &domain.QuestionFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, *questions)
+
+	_, err = repo.ReadTag(ctx, "go")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testRevisions(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	editor := createUser(t, repo, "editor")
+	questionId := createQuestion(t, repo, author, "question")
+	answerId := createAnswer(t, repo, questionId, author)
+
+	text := "edited"
+	_, err := repo.UpdateQuestion(ctx, questionId, &domain.QuestionPatch{Text: &text}, editor)
+	require.NoError(t, err)
+	// патч без изменений не создает ревизию
+	_, err = repo.UpdateQuestion(ctx, questionId, &domain.QuestionPatch{Text: &text}, editor)
+	require.NoError(t, err)
+
+	revisions, err := repo.ReadQuestionRevisions(ctx, questionId)
+	require.NoError(t, err)
+	require.Len(t, *revisions, 2)
+	assert.Equal(t, author, (*revisions)[0].EditorId)
+	assert.Equal(t, "text of question", (*revisions)[0].Text)
+	assert.Equal(t, editor, (*revisions)[1].EditorId)
+	assert.Equal(t, "question", (*revisions)[1].Title)
+	assert.Equal(t, "edited", (*revisions)[1].Text)
+	assert.Greater(t, (*revisions)[1].Id, (*revisions)[0].Id)
+
+	answer, err := repo.UpdateAnswer(ctx, answerId, &domain.AnswerPatch{Text: &text}, editor)
+	require.NoError(t, err)
+	assert.Equal(t, "edited", answer.Text)
+
+	revisions, err = repo.ReadAnswerRevisions(ctx, answerId)
+	require.NoError(t, err)
+	require.Len(t, *revisions, 2)
+	assert.Equal(t, "answer", (*revisions)[0].Text)
+	assert.Equal(t, editor, (*revisions)[1].EditorId)
+
+	_, err = repo.ReadQuestionRevisions(ctx, missingId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.UpdateAnswer(ctx, missingId, &domain.AnswerPatch{Text: &text}, editor)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testVotes(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	first := createUser(t, repo, "first")
+	second := createUser(t, repo, "second")
+	questionId := createQuestion(t, repo, first, "question")
+
+	result, err := repo.VoteQuestion(ctx, questionId, first, domain.VoteUp)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Score)
+	require.NotNil(t, result.Vote)
+	assert.Equal(t, domain.VoteUp, *result.Vote)
+
+	result, err = repo.VoteQuestion(ctx, questionId, second, domain.VoteDown)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Score)
+
+	// повторный голос меняет прежний
+	result, err = repo.VoteQuestion(ctx, questionId, first, domain.VoteDown)
+	require.NoError(t, err)
+	assert.Equal(t, -2, result.Score)
+
+	result, err = repo.RetractQuestionVote(ctx, questionId, first)
+	require.NoError(t, err)
+	assert.Equal(t, -1, result.Score)
+	assert.Nil(t, result.Vote)
+
+	question, err := repo.ReadQuestion(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, -1, question.Score)
+
+	_, err = repo.VoteQuestion(ctx, questionId, unknownUserId, domain.VoteUp)
+	assert.ErrorIs(t, err, domain.ErrReferenceNotFound)
+	_, err = repo.VoteAnswer(ctx, missingId, first, domain.VoteUp)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.RetractAnswerVote(ctx, missingId, first)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testStatus(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	questionId := createQuestion(t, repo, author, "question")
+	otherQuestionId := createQuestion(t, repo, author, "other")
+	answerId := createAnswer(t, repo, questionId, author)
+	otherAnswerId := createAnswer(t, repo, otherQuestionId, author)
+
+	_, err := repo.UpdateQuestionStatus(ctx, questionId, domain.QuestionStatusClosed, domain.QuestionStatusOpen)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	_, err = repo.UpdateQuestionStatus(ctx, missingId, domain.QuestionStatusOpen, domain.QuestionStatusClosed)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	question, err := repo.UpdateQuestionStatus(ctx, questionId, domain.QuestionStatusOpen, domain.QuestionStatusClosed)
+	require.NoError(t, err)
+	assert.Equal(t, domain.QuestionStatusClosed, question.Status)
+	// закрытый вопрос не принимает ответы
+	_, err = repo.CreateAnswerToQuestion(ctx, &domain.Answer{QuestionId: questionId, UserId: author, Text: "answer"})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	_, err = repo.UpdateQuestionStatus(ctx, questionId, domain.QuestionStatusClosed, domain.QuestionStatusOpen)
+	require.NoError(t, err)
+
+	// принять можно только ответ на этот вопрос
+	_, err = repo.AcceptAnswer(ctx, questionId, otherAnswerId, domain.QuestionStatusOpen)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	question, err = repo.AcceptAnswer(ctx, questionId, answerId, domain.QuestionStatusOpen)
+	require.NoError(t, err)
+	assert.Equal(t, domain.QuestionStatusAnswered, question.Status)
+	require.NotNil(t, question.AcceptedAnswerId)
+	assert.Equal(t, answerId, *question.AcceptedAnswerId)
+
+	// удаление принятого ответа снова открывает вопрос
+	require.NoError(t, repo.DeleteAnswer(ctx, answerId))
+	question, err = repo.ReadQuestion(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.QuestionStatusOpen, question.Status)
+	assert.Nil(t, question.AcceptedAnswerId)
+}
+
+func testTags(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	createQuestion(t, repo, author, "first", "go", "sql")
+	createQuestion(t, repo, author, "second", "go")
+
+	tags, err := repo.ReadTags(ctx, &domain.TagQuery{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Tag{{Name: "go", QuestionCount: 2}, {Name: "sql", QuestionCount: 1}}, *tags)
+
+	tags, err = repo.ReadTags(ctx, &domain.TagQuery{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Tag{{Name: "sql", QuestionCount: 1}}, *tags)
+
+	tag, err := repo.ReadTag(ctx, "go")
+	require.NoError(t, err)
+	assert.Equal(t, domain.Tag{Name: "go", QuestionCount: 2}, *tag)
+
+	_, err = repo.ReadTag(ctx, "rust")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testComments(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	questionId := createQuestion(t, repo, author, "question")
+	answerId := createAnswer(t, repo, questionId, author)
+
+	first := createComment(t, repo, domain.CommentParentQuestion, questionId, author)
+	second := createComment(t, repo, domain.CommentParentAnswer, answerId, author)
+	third := createComment(t, repo, domain.CommentParentQuestion, questionId, author)
+
+	comment, err := repo.ReadComment(ctx, second)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CommentParentAnswer, comment.Parent)
+	assert.Equal(t, answerId, comment.ParentId)
+	assert.Equal(t, author, comment.UserId)
+	assert.Equal(t, "comment", comment.Text)
+
+	comments, err := repo.ReadComments(ctx, domain.CommentParentQuestion, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, []int{first, third}, commentIds(*comments))
+
+	comments, err = repo.ReadThreadComments(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, []int{first, second, third}, commentIds(*comments))
+
+	_, err = repo.CreateComment(ctx, &domain.Comment{Parent: domain.CommentParentAnswer, ParentId: missingId, UserId: author, Text: "comment"})
+	assert.ErrorIs(t, err, domain.ErrReferenceNotFound)
+	_, err = repo.CreateComment(ctx, &domain.Comment{Parent: domain.CommentParentQuestion, ParentId: questionId, UserId: author, Text: "   "})
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	require.NoError(t, repo.DeleteComment(ctx, first))
+	_, err = repo.ReadComment(ctx, first)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.ErrorIs(t, repo.DeleteComment(ctx, first), domain.ErrNotFound)
+}
+
+func testDeleteUserCascade(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	other := createUser(t, repo, "other")
+
+	questionId := createQuestion(t, repo, author, "question")
+	answerToAuthor := createAnswer(t, repo, questionId, other)
+	otherQuestionId := createQuestion(t, repo, other, "other")
+	acceptedAnswer := createAnswer(t, repo, otherQuestionId, author)
+	_, err := repo.AcceptAnswer(ctx, otherQuestionId, acceptedAnswer, domain.QuestionStatusOpen)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteUser(ctx, &author, domain.UserDeletionCascade)
+	require.NoError(t, err)
+
+	// ответы на вопросы пользователя уходят вместе с вопросами и отдельно не перечисляются
+	assert.Equal(t, []int{questionId}, deleted.QuestionIds)
+	assert.Equal(t, []domain.DeletedAnswer{{Id: acceptedAnswer, QuestionId: otherQuestionId}}, deleted.Answers)
+
+	// в корзину уходят вопросы и ответы пользователя и ответы на его вопросы
+	_, err = repo.ReadQuestion(ctx, questionId)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.ReadAnswer(ctx, answerToAuthor)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = repo.ReadAnswer(ctx, acceptedAnswer)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	question, err := repo.ReadQuestion(ctx, otherQuestionId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.QuestionStatusOpen, question.Status)
+	assert.Nil(t, question.AcceptedAnswerId)
+
+	// имя удаленного в корзину пользователя остается занятым
+	name := "author"
+	_, err = repo.CreateUser(ctx, &name, nil)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	users, _, err := repo.ReadUsers(ctx, &domain.ListParams{Limit: domain.MaxPageLimit})
+	require.NoError(t, err)
+	for _, user := range *users {
+		assert.NotEqual(t, author, user.Id)
+	}
+
+	_, err = repo.DeleteUser(ctx, &author, domain.UserDeletionCascade)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func testDeleteUserAnonymize(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	other := createUser(t, repo, "other")
+
+	questionId := createQuestion(t, repo, author, "question")
+	commentId := createComment(t, repo, domain.CommentParentQuestion, questionId, author)
+	otherQuestionId := createQuestion(t, repo, other, "other")
+	_, err := repo.VoteQuestion(ctx, otherQuestionId, author, domain.VoteUp)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteUser(ctx, &author, domain.UserDeletionAnonymize)
+	require.NoError(t, err)
+	assert.Empty(t, deleted.QuestionIds)
+	assert.Empty(t, deleted.Answers)
+
+	question, err := repo.ReadQuestion(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeletedUserId, question.UserId)
+
+	revisions, err := repo.ReadQuestionRevisions(ctx, questionId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeletedUserId, (*revisions)[0].EditorId)
+
+	comment, err := repo.ReadComment(ctx, commentId)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeletedUserId, comment.UserId)
+
+	// голоса удаленного пользователя снимаются
+	question, err = repo.ReadQuestion(ctx, otherQuestionId)
+	require.NoError(t, err)
+	assert.Zero(t, question.Score)
+
+	// пользователь удален окончательно, его имя свободно
+	createUser(t, repo, "author")
+}
+
+func testDeleteUserBlock(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	author := createUser(t, repo, "author")
+	idle := createUser(t, repo, "idle")
+	questionId := createQuestion(t, repo, author, "question")
+
+	_, err := repo.DeleteUser(ctx, &author, domain.UserDeletionBlock)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	_, err = repo.ReadQuestion(ctx, questionId)
+	assert.NoError(t, err)
+
+	deleted, err := repo.DeleteUser(ctx, &idle, domain.UserDeletionBlock)
+	require.NoError(t, err)
+	assert.Empty(t, deleted.QuestionIds)
+	assert.Empty(t, deleted.Answers)
+
+	unknown := unknownUserId
+	_, err = repo.DeleteUser(ctx, &unknown, domain.UserDeletionBlock)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func createUser(t *testing.T, repo Repository, name string) string {
+	t.Helper()
+	userId, err := repo.CreateUser(context.Background(), &name, nil)
+	require.NoError(t, err)
+	return *userId
+}
+
+func createQuestion(t *testing.T, repo Repository, userId, title string, tags ...string) int {
+	t.Helper()
+	questionId, err := repo.CreateQuestion(context.Background(), &domain.Question{
+		UserId: userId,
+		Title:  title,
+		Text:   "text of " + title,
+		Tags:   tags,
+	})
+	require.NoError(t, err)
+	return questionId
+}
+
+func createAnswer(t *testing.T, repo Repository, questionId int, userId string) int {
+	t.Helper()
+	answerId, err := repo.CreateAnswerToQuestion(context.Background(), &domain.Answer{
+		QuestionId: questionId,
+		UserId:     userId,
+		Text:       "answer",
+	})
+	require.NoError(t, err)
+	return answerId
+}
+
+func createComment(t *testing.T, repo Repository, parent domain.CommentParent, parentId int, userId string) int {
+	t.Helper()
+	commentId, err := repo.CreateComment(context.Background(), &domain.Comment{
+		Parent:   parent,
+		ParentId: parentId,
+		UserId:   userId,
+		Text:     "comment",
+	})
+	require.NoError(t, err)
+	return commentId
+}
+
+func questionIds(questions []domain.Question) []int {
+	ids := make([]int, 0, len(questions))
+	for _, question := range questions {
+		ids = append(ids, question.Id)
+	}
+	return ids
+}
+
+func answerIds(answers []domain.Answer) []int {
+	ids := make([]int, 0, len(answers))
+	for _, answer := range answers {
+		ids = append(ids, answer.Id)
+	}
+	return ids
+}
+
+func commentIds(comments []domain.Comment) []int {
+	ids := make([]int, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.Id)
+	}
+	return ids
+}
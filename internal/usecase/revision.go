@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (t *QNACrud) UpdateQuestion(ctx context.Context, questionId int, patch *domain.QuestionPatch) (*domain.Question, error) {
+	const op = "internal/usecase/revision.QNACrud.UpdateQuestion"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	question, err := t.qnaManager.ReadQuestion(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if question.Status == domain.QuestionStatusLocked {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question is locked"), op)
+	}
+
+	err = t.policy.AuthorizeOwner(ctx, question.UserId, domain.PermissionQuestionEditOwn, domain.PermissionQuestionEditAny)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if patch.Title != nil {
+		title := strings.TrimSpace(*patch.Title)
+		if title == "" {
+			return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "question title must not be empty"), op)
+		}
+		if utf8.RuneCountInString(title) > domain.MaxQuestionTitleLength {
+			return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "question title is too long"), op)
+		}
+		patch.Title = &title
+	}
+	if patch.Text != nil && strings.TrimSpace(*patch.Text) == "" {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "question text must not be empty"), op)
+	}
+	if patch.Tags != nil {
+		tags, err := domain.NormalizeTags(*patch.Tags)
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		patch.Tags = &tags
+	}
+
+	principal, _ := domain.PrincipalFromContext(ctx)
+	question, err = t.qnaManager.UpdateQuestion(ctx, questionId, patch, principal.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return question, nil
+}
+
+func (t *QNACrud) UpdateAnswer(ctx context.Context, answerId int, patch *domain.AnswerPatch) (*domain.Answer, error) {
+	const op = "internal/usecase/revision.QNACrud.UpdateAnswer"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	answer, err := t.qnaManager.ReadAnswer(ctx, answerId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	err = t.policy.AuthorizeOwner(ctx, answer.UserId, domain.PermissionAnswerEditOwn, domain.PermissionAnswerEditAny)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if patch.Text != nil && strings.TrimSpace(*patch.Text) == "" {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "answer text must not be empty"), op)
+	}
+
+	principal, _ := domain.PrincipalFromContext(ctx)
+	answer, err = t.qnaManager.UpdateAnswer(ctx, answerId, patch, principal.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return answer, nil
+}
+
+func (t *QNACrud) UpdateUser(ctx context.Context, userId *string, patch *domain.UserPatch) (*domain.User, error) {
+	const op = "internal/usecase/revision.QNACrud.UpdateUser"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	err := t.policy.AuthorizeOwner(ctx, *userId, domain.PermissionUserEditOwn, domain.PermissionUserEditAny)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if *userId == domain.DeletedUserId {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "the deleted user placeholder cannot be changed"), op)
+	}
+
+	if patch.Name != nil {
+		name := strings.TrimSpace(*patch.Name)
+		if name == "" {
+			return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "user name must not be empty"), op)
+		}
+		if utf8.RuneCountInString(name) > domain.MaxUserNameLength {
+			return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "user name is too long"), op)
+		}
+		patch.Name = &name
+	}
+	if patch.Password != nil {
+		passwordLength := len(*patch.Password)
+		if passwordLength < domain.MinPasswordLength || passwordLength > domain.MaxPasswordLength {
+			return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "password length must be between 8 and 72 bytes"), op)
+		}
+		passwordHash, err := hashPassword(*patch.Password)
+		if err != nil {
+			return nil, errors.Wrap(err, op)
+		}
+		patch.Password = nil
+		patch.PasswordHash = &passwordHash
+	}
+
+	user, err := t.userManager.UpdateUser(ctx, userId, patch)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return user, nil
+}
+
+func (t *QNACrud) GetQuestionRevisions(ctx context.Context, questionId int) (*[]domain.Revision, error) {
+	const op = "internal/usecase/revision.QNACrud.GetQuestionRevisions"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	revisions, err := t.qnaManager.ReadQuestionRevisions(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return revisions, nil
+}
+
+func (t *QNACrud) GetAnswerRevisions(ctx context.Context, answerId int) (*[]domain.Revision, error) {
+	const op = "internal/usecase/revision.QNACrud.GetAnswerRevisions"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	revisions, err := t.qnaManager.ReadAnswerRevisions(ctx, answerId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return revisions, nil
+}
@@ -2,91 +2,234 @@ package usecase
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/Vy4cheSlave/qna/internal/domain"
 	"github.com/pkg/errors"
 )
 
 type QNAManager interface {
-	ReadQuestions(ctx context.Context) (*[]domain.Question, error)
-	CreateQuestion(ctx context.Context, question *string) (questionId int, err error)
+	ReadQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error)
+	CreateQuestion(ctx context.Context, question *domain.Question) (questionId int, err error)
+	ReadQuestion(ctx context.Context, questionId int) (*domain.Question, error)
 	ReadQuestionAndAnswers(ctx context.Context, questionId int) (*domain.Question, *[]domain.Answer, error)
 	DeleteQuestionAndAnswers(ctx context.Context, questionId int) error
+	// CreateAnswerToQuestion возвращает domain.ErrReferenceNotFound для отсутствующего
+	// вопроса и domain.ErrConflict для вопроса, который не принимает ответы.
 	CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (answerId int, err error)
 	ReadAnswer(ctx context.Context, answerId int) (*domain.Answer, error)
 	DeleteAnswer(ctx context.Context, answerId int) error
+	UpdateQuestion(ctx context.Context, questionId int, patch *domain.QuestionPatch, editorId string) (*domain.Question, error)
+	UpdateAnswer(ctx context.Context, answerId int, patch *domain.AnswerPatch, editorId string) (*domain.Answer, error)
+	ReadQuestionRevisions(ctx context.Context, questionId int) (*[]domain.Revision, error)
+	ReadAnswerRevisions(ctx context.Context, answerId int) (*[]domain.Revision, error)
+	VoteQuestion(ctx context.Context, questionId int, userId string, value domain.VoteValue) (*domain.VoteResult, error)
+	RetractQuestionVote(ctx context.Context, questionId int, userId string) (*domain.VoteResult, error)
+	VoteAnswer(ctx context.Context, answerId int, userId string, value domain.VoteValue) (*domain.VoteResult, error)
+	RetractAnswerVote(ctx context.Context, answerId int, userId string) (*domain.VoteResult, error)
+	UpdateQuestionStatus(ctx context.Context, questionId int, from, to domain.QuestionStatus) (*domain.Question, error)
+	AcceptAnswer(ctx context.Context, questionId int, answerId int, from domain.QuestionStatus) (*domain.Question, error)
+	ReadTags(ctx context.Context, query *domain.TagQuery) (*[]domain.Tag, error)
+	ReadTag(ctx context.Context, name string) (*domain.Tag, error)
+	CreateComment(ctx context.Context, comment *domain.Comment) (commentId int, err error)
+	ReadComment(ctx context.Context, commentId int) (*domain.Comment, error)
+	ReadComments(ctx context.Context, parent domain.CommentParent, parentId int) (*[]domain.Comment, error)
+	ReadThreadComments(ctx context.Context, questionId int) (*[]domain.Comment, error)
+	DeleteComment(ctx context.Context, commentId int) error
 }
 
 type UserManager interface {
-	CreateUser(ctx context.Context, userName *string) (userId *string, err error)
-	ReadUsers(ctx context.Context) (*[]domain.User, error)
-	DeleteUser(ctx context.Context, userId *string) error
+	CreateUser(ctx context.Context, userName *string, passwordHash *string) (userId *string, err error)
+	ReadUsers(ctx context.Context, params *domain.ListParams) (*[]domain.User, *domain.Page, error)
+	UpdateUser(ctx context.Context, userId *string, patch *domain.UserPatch) (*domain.User, error)
+	// DeleteUser возвращает вопросы и ответы, помещенные в корзину вместе с пользователем;
+	// при правиле anonymize список пуст.
+	DeleteUser(ctx context.Context, userId *string, policy domain.UserDeletionPolicy) (*domain.DeletedContent, error)
+}
+
+type Searcher interface {
+	Search(ctx context.Context, query *domain.SearchQuery) (*[]domain.SearchHit, error)
 }
 
 type QNACrud struct {
 	qnaManager  QNAManager
 	userManager UserManager
+	searcher    Searcher
+	trash       TrashManager
+	webhooks    WebhookManager
+	policy      *Policy
+	// userDeletion - судьба публикаций удаляемого пользователя
+	userDeletion domain.UserDeletionPolicy
+	// events получает доменные события после успешных записей; может быть nil
+	events EventPublisher
+	// metrics учитывает созданные и удаленные сущности; может быть nil
+	metrics MetricsRecorder
+}
+
+// QNADeps - зависимости сервиса QNACrud. Поля, помеченные как необязательные,
+// могут оставаться нулевыми.
+type QNADeps struct {
+	QNAManager  QNAManager
+	UserManager UserManager
+	Searcher    Searcher
+	Trash       TrashManager
+	Webhooks    WebhookManager
+	Policy      *Policy
+	// UserDeletion - судьба публикаций удаляемого пользователя
+	UserDeletion domain.UserDeletionPolicy
+	// Events - необязательный издатель доменных событий
+	Events EventPublisher
+	// Metrics - необязательный учет созданных и удаленных сущностей
+	Metrics MetricsRecorder
 }
 
-func NewQNAManagerService(qnaManager QNAManager, userManager UserManager) *QNACrud {
+func NewQNAManagerService(deps QNADeps) *QNACrud {
 	return &QNACrud{
-		qnaManager:  qnaManager,
-		userManager: userManager,
+		qnaManager:   deps.QNAManager,
+		userManager:  deps.UserManager,
+		searcher:     deps.Searcher,
+		trash:        deps.Trash,
+		webhooks:     deps.Webhooks,
+		policy:       deps.Policy,
+		userDeletion: deps.UserDeletion,
+		events:       deps.Events,
+		metrics:      deps.Metrics,
 	}
 }
 
-func (t *QNACrud) CreateUser(ctx context.Context, userName *string) (userId *string, err error) {
+func (t *QNACrud) CreateUser(ctx context.Context, userName *string, password *string) (userId *string, err error) {
 	const op = "internal/usecase/service.QNACrud.CreateUser"
 
-	userId, err = t.userManager.CreateUser(ctx, userName)
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	passwordLength := len(*password)
+	if passwordLength < domain.MinPasswordLength || passwordLength > domain.MaxPasswordLength {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "password length must be between 8 and 72 bytes"), op)
+	}
+
+	passwordHash, err := hashPassword(*password)
 	if err != nil {
 		return nil, errors.Wrap(err, op)
 	}
+
+	userId, err = t.userManager.CreateUser(ctx, userName, &passwordHash)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	t.recordCreated(entityUser)
 	return userId, nil
 }
 
-func (t *QNACrud) GetUsers(ctx context.Context) (*[]domain.User, error) {
+func (t *QNACrud) GetUsers(ctx context.Context, params *domain.ListParams) (*[]domain.User, *domain.Page, error) {
 	const op = "internal/usecase/service.QNACrud.GetUsers"
 
-	users, err := t.userManager.ReadUsers(ctx)
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	params.Normalize()
+	users, page, err := t.userManager.ReadUsers(ctx, params)
 	if err != nil {
-		return nil, errors.Wrap(err, op)
+		return nil, nil, errors.Wrap(err, op)
 	}
-	return users, nil
+	return users, page, nil
 }
 
 func (t *QNACrud) DeleteUser(ctx context.Context, userId *string) error {
-	const op = "internal/usecase/service.QNACrud.GetUsers"
+	const op = "internal/usecase/service.QNACrud.DeleteUser"
 
-	err := t.userManager.DeleteUser(ctx, userId)
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionUserDelete); err != nil {
+		return errors.Wrap(err, op)
+	}
+	if *userId == domain.DeletedUserId {
+		return errors.Wrap(domain.NewError(domain.ErrConflict, "the deleted user placeholder cannot be deleted"), op)
+	}
+
+	deleted, err := t.userManager.DeleteUser(ctx, userId, t.userDeletion)
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+
+	for _, event := range deleted.Events(time.Now()) {
+		t.publish(ctx, event)
+	}
+	t.recordDeleted(entityUser)
 	return nil
 }
 
-func (t *QNACrud) GetQuestions(ctx context.Context) (*[]domain.Question, error) {
+func (t *QNACrud) GetQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error) {
 	const op = "internal/usecase/service.QNACrud.GetQuestions"
 
-	questions, err := t.qnaManager.ReadQuestions(ctx)
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	params.Normalize()
+	for _, status := range filter.Statuses {
+		if !status.Valid() {
+			return nil, nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown question status"), op)
+		}
+	}
+	if err := normalizeTagFilter(filter); err != nil {
+		return nil, nil, errors.Wrap(err, op)
+	}
+
+	questions, page, err := t.qnaManager.ReadQuestions(ctx, params, filter)
 	if err != nil {
-		return nil, errors.Wrap(err, op)
+		return nil, nil, errors.Wrap(err, op)
 	}
-	return questions, nil
+	return questions, page, nil
 }
 
-func (t *QNACrud) CreateQuestion(ctx context.Context, question *string) (questionId int, err error) {
+func (t *QNACrud) CreateQuestion(ctx context.Context, question *domain.Question) (questionId int, err error) {
 	const op = "internal/usecase/service.QNACrud.CreateQuestion"
 
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	// автором вопроса всегда является аутентифицированный пользователь
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return 0, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+	question.UserId = principal.UserId
+
+	question.Title = strings.TrimSpace(question.Title)
+	if question.Title == "" {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "question title must not be empty"), op)
+	}
+	if utf8.RuneCountInString(question.Title) > domain.MaxQuestionTitleLength {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "question title is too long"), op)
+	}
+	if strings.TrimSpace(question.Text) == "" {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "question text must not be empty"), op)
+	}
+	question.Tags, err = domain.NormalizeTags(question.Tags)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+
 	questionId, err = t.qnaManager.CreateQuestion(ctx, question)
 	if err != nil {
 		return 0, errors.Wrap(err, op)
 	}
+	t.publish(ctx, domain.Event{Type: domain.EventQuestionCreated, QuestionId: questionId})
+	t.recordCreated(entityQuestion)
 	return questionId, nil
 }
 
 func (t *QNACrud) GetQuestionAndAnswers(ctx context.Context, questionId int) (*domain.Question, *[]domain.Answer, error) {
 	const op = "internal/usecase/service.QNACrud.GetQuestionAndAnswers"
 
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
 	question, answers, err := t.qnaManager.ReadQuestionAndAnswers(ctx, questionId)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, op)
@@ -97,26 +240,56 @@ func (t *QNACrud) GetQuestionAndAnswers(ctx context.Context, questionId int) (*d
 func (t *QNACrud) DeleteQuestionAndAnswers(ctx context.Context, questionId int) error {
 	const op = "internal/usecase/service.QNACrud.DeleteQuestionAndAnswers"
 
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionQuestionDelete); err != nil {
+		return errors.Wrap(err, op)
+	}
+
 	err := t.qnaManager.DeleteQuestionAndAnswers(ctx, questionId)
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+	t.publish(ctx, domain.Event{Type: domain.EventQuestionDeleted, QuestionId: questionId})
+	t.recordDeleted(entityQuestion)
 	return nil
 }
 
 func (t *QNACrud) CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (answerId int, err error) {
 	const op = "internal/usecase/service.QNACrud.CreateAnswerToQuestion"
 
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	// автором ответа всегда является аутентифицированный пользователь
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return 0, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+	if answer.UserId == "" {
+		answer.UserId = principal.UserId
+	}
+	if answer.UserId != principal.UserId {
+		return 0, errors.Wrap(domain.NewError(domain.ErrForbidden, "answers can only be posted on behalf of the authenticated user"), op)
+	}
+
+	// состояние вопроса проверяет репозиторий в транзакции добавления ответа
 	answerId, err = t.qnaManager.CreateAnswerToQuestion(ctx, answer)
 	if err != nil {
 		return 0, errors.Wrap(err, op)
 	}
+	t.publish(ctx, domain.Event{Type: domain.EventAnswerCreated, QuestionId: answer.QuestionId, AnswerId: answerId})
+	t.recordCreated(entityAnswer)
 	return answerId, nil
 }
 
 func (t *QNACrud) GetAnswer(ctx context.Context, answerId int) (*domain.Answer, error) {
 	const op = "internal/usecase/service.QNACrud.GetAnswer"
 
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
 	answers, err := t.qnaManager.ReadAnswer(ctx, answerId)
 	if err != nil {
 		return nil, errors.Wrap(err, op)
@@ -127,9 +300,54 @@ func (t *QNACrud) GetAnswer(ctx context.Context, answerId int) (*domain.Answer,
 func (t *QNACrud) DeleteAnswer(ctx context.Context, answerId int) error {
 	const op = "internal/usecase/service.QNACrud.DeleteAnswer"
 
-	err := t.qnaManager.DeleteAnswer(ctx, answerId)
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	answer, err := t.qnaManager.ReadAnswer(ctx, answerId)
 	if err != nil {
 		return errors.Wrap(err, op)
 	}
+
+	err = t.policy.AuthorizeOwner(ctx, answer.UserId, domain.PermissionAnswerDeleteOwn, domain.PermissionAnswerDeleteAny)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	err = t.qnaManager.DeleteAnswer(ctx, answerId)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	t.publish(ctx, domain.Event{Type: domain.EventAnswerDeleted, QuestionId: answer.QuestionId, AnswerId: answerId})
+	t.recordDeleted(entityAnswer)
 	return nil
 }
+
+func (t *QNACrud) Search(ctx context.Context, query *domain.SearchQuery) (*[]domain.SearchHit, error) {
+	const op = "internal/usecase/service.QNACrud.Search"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "search query must not be empty"), op)
+	}
+	if utf8.RuneCountInString(query.Text) > domain.MaxSearchQueryLength {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "search query is too long"), op)
+	}
+	if query.Language != "" && !slices.Contains(domain.SearchLanguages, query.Language) {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unsupported search language"), op)
+	}
+	if query.Limit <= 0 || query.Limit > domain.MaxPageLimit {
+		query.Limit = domain.DefaultPageLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	hits, err := t.searcher.Search(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return hits, nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+// AcceptAnswer отмечает ответ принятым. Принять ответ может только автор
+// вопроса, пока вопрос открыт; принятие другого ответа заменяет прежний.
+func (t *QNACrud) AcceptAnswer(ctx context.Context, questionId int, answerId int) (*domain.Question, error) {
+	const op = "internal/usecase/status.QNACrud.AcceptAnswer"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+
+	question, err := t.qnaManager.ReadQuestion(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if question.UserId != principal.UserId {
+		return nil, errors.Wrap(domain.NewError(domain.ErrForbidden, "only the question author can accept an answer"), op)
+	}
+	if !question.Status.AcceptsAnswers() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "question is "+string(question.Status)), op)
+	}
+
+	answer, err := t.qnaManager.ReadAnswer(ctx, answerId)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, errors.Wrap(domain.NewError(domain.ErrReferenceNotFound, "answer not found"), op)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if answer.QuestionId != questionId {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "answer does not belong to the question"), op)
+	}
+
+	question, err = t.qnaManager.AcceptAnswer(ctx, questionId, answerId, question.Status)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return question, nil
+}
+
+// ChangeQuestionStatus закрывает, блокирует или заново открывает вопрос.
+// Закрыть и открыть вопрос может автор или модератор, блокировка и снятие
+// блокировки требуют права question.lock. Состояние answered выставляется
+// только принятием ответа: повторно открытый вопрос с принятым ответом
+// возвращается в answered.
+func (t *QNACrud) ChangeQuestionStatus(ctx context.Context, questionId int, status domain.QuestionStatus) (*domain.Question, error) {
+	const op = "internal/usecase/status.QNACrud.ChangeQuestionStatus"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if !status.Valid() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown question status"), op)
+	}
+	if status == domain.QuestionStatusAnswered {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "status \"answered\" is set by accepting an answer"), op)
+	}
+
+	question, err := t.qnaManager.ReadQuestion(ctx, questionId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if status == domain.QuestionStatusOpen && question.AcceptedAnswerId != nil {
+		status = domain.QuestionStatusAnswered
+	}
+
+	if question.Status == domain.QuestionStatusLocked || status == domain.QuestionStatusLocked {
+		err = t.policy.Authorize(ctx, domain.PermissionQuestionLock)
+	} else {
+		err = t.policy.AuthorizeOwner(ctx, question.UserId, domain.PermissionQuestionCloseOwn, domain.PermissionQuestionCloseAny)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if question.Status == status {
+		return question, nil
+	}
+	if !question.Status.CanTransitionTo(status) {
+		return nil, errors.Wrap(domain.NewError(domain.ErrConflict, "cannot change question status from \""+string(question.Status)+"\" to \""+string(status)+"\""), op)
+	}
+
+	question, err = t.qnaManager.UpdateQuestionStatus(ctx, questionId, question.Status, status)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return question, nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// questionStub хранит один вопрос; остальные методы QNAManager не вызываются.
+type questionStub struct {
+	QNAManager
+	question domain.Question
+}
+
+func (t *questionStub) ReadQuestion(ctx context.Context, questionId int) (*domain.Question, error) {
+	if questionId != t.question.Id {
+		return nil, domain.ErrNotFound
+	}
+	question := t.question
+	return &question, nil
+}
+
+// CreateAnswerToQuestion проверяет состояние вопроса, как это делает репозиторий.
+func (t *questionStub) CreateAnswerToQuestion(ctx context.Context, answer *domain.Answer) (int, error) {
+	if answer.QuestionId != t.question.Id {
+		return 0, domain.ErrReferenceNotFound
+	}
+	if !t.question.Status.AcceptsAnswers() {
+		return 0, domain.ErrConflict
+	}
+	return 1, nil
+}
+
+func (t *questionStub) UpdateQuestionStatus(ctx context.Context, questionId int, from, to domain.QuestionStatus) (*domain.Question, error) {
+	if t.question.Status != from {
+		return nil, domain.ErrConflict
+	}
+	t.question.Status = to
+	return t.ReadQuestion(ctx, questionId)
+}
+
+func TestChangeQuestionStatus(t *testing.T) {
+	const (
+		author    = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+		moderator = "9b2f7e8a-1f4e-4c1a-9d8e-2b1e2f3a4b5c"
+	)
+	acceptedAnswerId := 7
+
+	policy := NewPolicy(permissionsStub{
+		author:    {domain.PermissionQuestionCloseOwn},
+		moderator: {domain.PermissionQuestionCloseOwn, domain.PermissionQuestionCloseAny, domain.PermissionQuestionLock},
+	})
+
+	testCases := []struct {
+		name           string
+		principal      string
+		question       domain.Question
+		status         domain.QuestionStatus
+		expectedStatus domain.QuestionStatus
+		expectedErr    error
+	}{
+		{
+			name:           "author closes",
+			principal:      author,
+			question:       domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusOpen},
+			status:         domain.QuestionStatusClosed,
+			expectedStatus: domain.QuestionStatusClosed,
+		},
+		{
+			name:           "reopen with accepted answer",
+			principal:      author,
+			question:       domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusClosed, AcceptedAnswerId: &acceptedAnswerId},
+			status:         domain.QuestionStatusOpen,
+			expectedStatus: domain.QuestionStatusAnswered,
+		},
+		{
+			name:        "author cannot lock",
+			principal:   author,
+			question:    domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusOpen},
+			status:      domain.QuestionStatusLocked,
+			expectedErr: domain.ErrForbidden,
+		},
+		{
+			name:        "author cannot unlock",
+			principal:   author,
+			question:    domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusLocked},
+			status:      domain.QuestionStatusOpen,
+			expectedErr: domain.ErrForbidden,
+		},
+		{
+			name:           "moderator locks",
+			principal:      moderator,
+			question:       domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusAnswered, AcceptedAnswerId: &acceptedAnswerId},
+			status:         domain.QuestionStatusLocked,
+			expectedStatus: domain.QuestionStatusLocked,
+		},
+		{
+			name:        "answered is set only by accepting",
+			principal:   moderator,
+			question:    domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusOpen},
+			status:      domain.QuestionStatusAnswered,
+			expectedErr: domain.ErrValidation,
+		},
+		{
+			name:        "unknown status",
+			principal:   moderator,
+			question:    domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusOpen},
+			status:      "archived",
+			expectedErr: domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewQNAManagerService(QNADeps{QNAManager: &questionStub{question: tt.question}, Policy: policy, UserDeletion: domain.UserDeletionCascade})
+			ctx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{UserId: tt.principal})
+
+			question, err := service.ChangeQuestionStatus(ctx, tt.question.Id, tt.status)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, question.Status)
+		})
+	}
+}
+
+func TestCreateAnswerToClosedQuestion(t *testing.T) {
+	const author = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+
+	for _, status := range []domain.QuestionStatus{domain.QuestionStatusClosed, domain.QuestionStatusLocked} {
+		t.Run(string(status), func(t *testing.T) {
+			stub := &questionStub{question: domain.Question{Id: 1, UserId: author, Status: status}}
+			service := NewQNAManagerService(QNADeps{QNAManager: stub, Policy: NewPolicy(permissionsStub{}), UserDeletion: domain.UserDeletionCascade})
+			ctx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{UserId: author})
+
+			_, err := service.CreateAnswerToQuestion(ctx, &domain.Answer{QuestionId: 1, Text: "text"})
+			assert.ErrorIs(t, err, domain.ErrConflict)
+		})
+	}
+}
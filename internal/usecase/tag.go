@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (t *QNACrud) GetTags(ctx context.Context, query *domain.TagQuery) (*[]domain.Tag, error) {
+	const op = "internal/usecase/tag.QNACrud.GetTags"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if query.Limit <= 0 || query.Limit > domain.MaxPageLimit {
+		query.Limit = domain.DefaultPageLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	tags, err := t.qnaManager.ReadTags(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return tags, nil
+}
+
+// GetTagQuestions возвращает вопросы с тегом; для неизвестного тега - domain.ErrNotFound.
+func (t *QNACrud) GetTagQuestions(ctx context.Context, name string, params *domain.ListParams) (*[]domain.Question, *domain.Page, error) {
+	const op = "internal/usecase/tag.QNACrud.GetTagQuestions"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	name, err := domain.NormalizeTag(name)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, op)
+	}
+
+	if _, err := t.qnaManager.ReadTag(ctx, name); err != nil {
+		return nil, nil, errors.Wrap(err, op)
+	}
+
+	params.Normalize()
+	questions, page, err := t.qnaManager.ReadQuestions(ctx, params, &domain.QuestionFilter{Tags: []string{name}})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, op)
+	}
+	return questions, page, nil
+}
+
+// normalizeTagFilter приводит теги фильтра к каноническому виду и
+// по умолчанию требует совпадения всех тегов.
+func normalizeTagFilter(filter *domain.QuestionFilter) error {
+	switch filter.TagMatch {
+	case "":
+		filter.TagMatch = domain.TagMatchAll
+	case domain.TagMatchAll, domain.TagMatchAny:
+	default:
+		return domain.NewError(domain.ErrValidation, "tag match must be one of: all, any")
+	}
+
+	tags := make([]string, 0, len(filter.Tags))
+	for _, name := range filter.Tags {
+		tag, err := domain.NormalizeTag(name)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	filter.Tags = tags
+	return nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tagStub знает один тег и запоминает фильтр последнего запроса вопросов.
+type tagStub struct {
+	QNAManager
+	tag    string
+	filter *domain.QuestionFilter
+}
+
+func (t *tagStub) ReadTag(ctx context.Context, name string) (*domain.Tag, error) {
+	if name != t.tag {
+		return nil, domain.ErrNotFound
+	}
+	return &domain.Tag{Name: name, QuestionCount: 1}, nil
+}
+
+func (t *tagStub) ReadQuestions(ctx context.Context, params *domain.ListParams, filter *domain.QuestionFilter) (*[]domain.Question, *domain.Page, error) {
+	t.filter = filter
+	return &[]domain.Question{}, &domain.Page{}, nil
+}
+
+func TestGetTagQuestions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tag            string
+		expectedFilter *domain.QuestionFilter
+		expectedErr    error
+	}{
+		{
+			name:           "Normalized name",
+			tag:            " Go Modules ",
+			expectedFilter: &domain.QuestionFilter{Tags: []string{"go-modules"}},
+		},
+		{name: "Empty tag", tag: "   ", expectedErr: domain.ErrValidation},
+		{name: "Invalid characters", tag: "go/lang", expectedErr: domain.ErrValidation},
+		{name: "Unknown tag", tag: "rust", expectedErr: domain.ErrNotFound},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &tagStub{tag: "go-modules"}
+			service := NewQNAManagerService(QNADeps{QNAManager: stub})
+
+			_, _, err := service.GetTagQuestions(context.Background(), tt.tag, &domain.ListParams{})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, stub.filter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFilter, stub.filter)
+		})
+	}
+}
+
+func TestGetQuestionsTagFilter(t *testing.T) {
+	testCases := []struct {
+		name           string
+		filter         domain.QuestionFilter
+		expectedFilter *domain.QuestionFilter
+		expectedErr    error
+	}{
+		{
+			name:           "All tags by default",
+			filter:         domain.QuestionFilter{Tags: []string{"Go", "SQL", "go"}},
+			expectedFilter: &domain.QuestionFilter{Tags: []string{"go", "sql"}, TagMatch: domain.TagMatchAll},
+		},
+		{
+			name:           "Any tag",
+			filter:         domain.QuestionFilter{Tags: []string{"go", "Go Modules"}, TagMatch: domain.TagMatchAny},
+			expectedFilter: &domain.QuestionFilter{Tags: []string{"go", "go-modules"}, TagMatch: domain.TagMatchAny},
+		},
+		{
+			name:        "Unknown tag match",
+			filter:      domain.QuestionFilter{Tags: []string{"go"}, TagMatch: "some"},
+			expectedErr: domain.ErrValidation,
+		},
+		{
+			name:        "Invalid tag",
+			filter:      domain.QuestionFilter{Tags: []string{"go", "go/lang"}},
+			expectedErr: domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &tagStub{}
+			service := NewQNAManagerService(QNADeps{QNAManager: stub})
+
+			_, _, err := service.GetQuestions(context.Background(), &domain.ListParams{}, &tt.filter)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, stub.filter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFilter, stub.filter)
+		})
+	}
+}
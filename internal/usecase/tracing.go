@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/Vy4cheSlave/qna/internal/usecase"
+
+// startSpan открывает span метода сервиса; имя span - константа op метода.
+func startSpan(ctx context.Context, op string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, op)
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestServiceSpans(t *testing.T) {
+	const author = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previousProvider) })
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	service := NewQNAManagerService(QNADeps{
+		QNAManager:   &questionStub{question: domain.Question{Id: 1, UserId: author, Status: domain.QuestionStatusOpen}},
+		Policy:       NewPolicy(permissionsStub{author: {domain.PermissionQuestionCloseOwn}}),
+		UserDeletion: domain.UserDeletionCascade,
+	})
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+	ctx = domain.ContextWithPrincipal(ctx, &domain.Principal{UserId: author})
+	_, err := service.ChangeQuestionStatus(ctx, 1, domain.QuestionStatusClosed)
+	require.NoError(t, err)
+	parent.End()
+
+	// span метода сервиса назван константой op и вложен в span запроса
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "internal/usecase/status.QNACrud.ChangeQuestionStatus", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+type TrashManager interface {
+	ReadTrash(ctx context.Context, query *domain.TrashQuery) (*[]domain.TrashItem, error)
+	RestoreQuestion(ctx context.Context, questionId int) error
+	RestoreAnswer(ctx context.Context, answerId int) error
+	RestoreUser(ctx context.Context, userId *string) error
+}
+
+func (t *QNACrud) GetTrash(ctx context.Context, query *domain.TrashQuery) (*[]domain.TrashItem, error) {
+	const op = "internal/usecase/trash.QNACrud.GetTrash"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionTrashManage); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if query.Kind != "" && !query.Kind.Valid() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown trash item kind"), op)
+	}
+	if query.Limit <= 0 || query.Limit > domain.MaxPageLimit {
+		query.Limit = domain.DefaultPageLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	items, err := t.trash.ReadTrash(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return items, nil
+}
+
+func (t *QNACrud) RestoreQuestion(ctx context.Context, questionId int) error {
+	const op = "internal/usecase/trash.QNACrud.RestoreQuestion"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionTrashManage); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := t.trash.RestoreQuestion(ctx, questionId); err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
+
+func (t *QNACrud) RestoreAnswer(ctx context.Context, answerId int) error {
+	const op = "internal/usecase/trash.QNACrud.RestoreAnswer"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionTrashManage); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := t.trash.RestoreAnswer(ctx, answerId); err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
+
+func (t *QNACrud) RestoreUser(ctx context.Context, userId *string) error {
+	const op = "internal/usecase/trash.QNACrud.RestoreUser"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionTrashManage); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := t.trash.RestoreUser(ctx, userId); err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// userDeletionStub запоминает правило, с которым удалялся пользователь,
+// и при правиле cascade сообщает об удаленном вопросе и ответе на чужой вопрос.
+type userDeletionStub struct {
+	UserManager
+	deleted map[string]domain.UserDeletionPolicy
+}
+
+func (t *userDeletionStub) DeleteUser(ctx context.Context, userId *string, policy domain.UserDeletionPolicy) (*domain.DeletedContent, error) {
+	t.deleted[*userId] = policy
+	if policy != domain.UserDeletionCascade {
+		return &domain.DeletedContent{}, nil
+	}
+	return &domain.DeletedContent{
+		QuestionIds: []int{1},
+		Answers:     []domain.DeletedAnswer{{Id: 2, QuestionId: 3}},
+	}, nil
+}
+
+func TestDeleteUser(t *testing.T) {
+	const (
+		admin = "9b2f7e8a-1f4e-4c1a-9d8e-2b1e2f3a4b5c"
+		user  = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+	)
+
+	policy := NewPolicy(permissionsStub{
+		admin: {domain.PermissionUserDelete},
+	})
+
+	testCases := []struct {
+		name           string
+		principal      string
+		userId         string
+		userDeletion   domain.UserDeletionPolicy
+		expectedEvents []domain.Event
+		expectedErr    error
+	}{
+		{
+			name:         "cascade",
+			principal:    admin,
+			userId:       user,
+			userDeletion: domain.UserDeletionCascade,
+			expectedEvents: []domain.Event{
+				{Type: domain.EventQuestionDeleted, QuestionId: 1},
+				{Type: domain.EventAnswerDeleted, QuestionId: 3, AnswerId: 2},
+			},
+		},
+		{name: "anonymize", principal: admin, userId: user, userDeletion: domain.UserDeletionAnonymize},
+		{name: "block", principal: admin, userId: user, userDeletion: domain.UserDeletionBlock},
+		{
+			name:         "placeholder cannot be deleted",
+			principal:    admin,
+			userId:       domain.DeletedUserId,
+			userDeletion: domain.UserDeletionAnonymize,
+			expectedErr:  domain.ErrConflict,
+		},
+		{
+			name:         "not enough permissions",
+			principal:    user,
+			userId:       user,
+			userDeletion: domain.UserDeletionCascade,
+			expectedErr:  domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &userDeletionStub{deleted: map[string]domain.UserDeletionPolicy{}}
+			broker := NewEventBroker(10)
+			service := NewQNAManagerService(QNADeps{UserManager: stub, Policy: policy, UserDeletion: tt.userDeletion, Events: broker})
+			ctx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{UserId: tt.principal})
+
+			userId := tt.userId
+			err := service.DeleteUser(ctx, &userId)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, stub.deleted)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.userDeletion, stub.deleted[tt.userId])
+
+			published, _, cancel := broker.Subscribe(0, nil)
+			defer cancel()
+			var events []domain.Event
+			for _, event := range published {
+				assert.False(t, event.OccurredAt.IsZero())
+				events = append(events, domain.Event{Type: event.Type, QuestionId: event.QuestionId, AnswerId: event.AnswerId})
+			}
+			assert.Equal(t, tt.expectedEvents, events)
+		})
+	}
+}
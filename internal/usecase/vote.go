@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+func (t *QNACrud) VoteQuestion(ctx context.Context, questionId int, value domain.VoteValue) (*domain.VoteResult, error) {
+	const op = "internal/usecase/vote.QNACrud.VoteQuestion"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+	if !value.Valid() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "vote must be 1 or -1"), op)
+	}
+
+	result, err := t.qnaManager.VoteQuestion(ctx, questionId, principal.UserId, value)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (t *QNACrud) RetractQuestionVote(ctx context.Context, questionId int) (*domain.VoteResult, error) {
+	const op = "internal/usecase/vote.QNACrud.RetractQuestionVote"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+
+	result, err := t.qnaManager.RetractQuestionVote(ctx, questionId, principal.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (t *QNACrud) VoteAnswer(ctx context.Context, answerId int, value domain.VoteValue) (*domain.VoteResult, error) {
+	const op = "internal/usecase/vote.QNACrud.VoteAnswer"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+	if !value.Valid() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "vote must be 1 or -1"), op)
+	}
+
+	result, err := t.qnaManager.VoteAnswer(ctx, answerId, principal.UserId, value)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
+
+func (t *QNACrud) RetractAnswerVote(ctx context.Context, answerId int) (*domain.VoteResult, error) {
+	const op = "internal/usecase/vote.QNACrud.RetractAnswerVote"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+
+	result, err := t.qnaManager.RetractAnswerVote(ctx, answerId, principal.UserId)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return result, nil
+}
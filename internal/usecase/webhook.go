@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"slices"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+type WebhookManager interface {
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) (webhookId int, err error)
+	DeleteWebhook(ctx context.Context, webhookId int) error
+	ReadWebhookDeliveries(ctx context.Context, query *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error)
+}
+
+func (t *QNACrud) CreateWebhook(ctx context.Context, webhook *domain.Webhook) (webhookId int, err error) {
+	const op = "internal/usecase/webhook.QNACrud.CreateWebhook"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionWebhookManage); err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return 0, errors.Wrap(domain.ErrUnauthorized, op)
+	}
+	webhook.UserId = principal.UserId
+
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" ||
+		len(webhook.URL) > domain.MaxWebhookURLLength {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "webhook url must be an absolute http or https URL up to 2048 characters"), op)
+	}
+	if len(webhook.Secret) < domain.MinWebhookSecretLength {
+		return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "webhook secret must be at least 16 characters"), op)
+	}
+	for _, eventType := range webhook.Events {
+		if !eventType.Valid() {
+			return 0, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown event type"), op)
+		}
+	}
+	slices.Sort(webhook.Events)
+	webhook.Events = slices.Compact(webhook.Events)
+
+	webhookId, err = t.webhooks.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	return webhookId, nil
+}
+
+func (t *QNACrud) DeleteWebhook(ctx context.Context, webhookId int) error {
+	const op = "internal/usecase/webhook.QNACrud.DeleteWebhook"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionWebhookManage); err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	if err := t.webhooks.DeleteWebhook(ctx, webhookId); err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
+
+func (t *QNACrud) GetWebhookDeliveries(ctx context.Context, query *domain.DeliveryQuery) (*[]domain.WebhookDelivery, error) {
+	const op = "internal/usecase/webhook.QNACrud.GetWebhookDeliveries"
+
+	ctx, span := startSpan(ctx, op)
+	defer span.End()
+
+	if err := t.policy.Authorize(ctx, domain.PermissionWebhookManage); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+
+	if query.Status != "" && !query.Status.Valid() {
+		return nil, errors.Wrap(domain.NewError(domain.ErrValidation, "unknown delivery status"), op)
+	}
+	if query.Limit <= 0 || query.Limit > domain.MaxPageLimit {
+		query.Limit = domain.DefaultPageLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	deliveries, err := t.webhooks.ReadWebhookDeliveries(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxWebhookRetryDelay ограничивает экспоненциальный рост паузы между попытками
+	maxWebhookRetryDelay = 6 * time.Hour
+	// maxWebhookResponseBody - сколько байт ответа подписчика читается, чтобы
+	// соединение можно было переиспользовать
+	maxWebhookResponseBody = 64 << 10
+
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+type WebhookQueue interface {
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookJob, error)
+	UpdateWebhookDelivery(ctx context.Context, deliveryId int64, result *domain.DeliveryResult) error
+}
+
+// WebhookSender - пул обработчиков, отправляющих события из очереди доставок.
+// Обработчики разных экземпляров сервиса не мешают друг другу: каждая доставка
+// захватывается одним из них. Неудачная попытка повторяется с экспоненциальной
+// задержкой, после maxAttempts попыток доставка считается неудавшейся.
+type WebhookSender struct {
+	queue        WebhookQueue
+	client       *http.Client
+	workers      int
+	pollInterval time.Duration
+	maxAttempts  int
+	retryDelay   time.Duration
+	// lease - время, на которое захватывается доставка; больше таймаута запроса
+	lease time.Duration
+	log   *slog.Logger
+	now   func() time.Time
+}
+
+func NewWebhookSender(
+	queue WebhookQueue,
+	client *http.Client,
+	workers int,
+	pollInterval time.Duration,
+	maxAttempts int,
+	retryDelay time.Duration,
+	log *slog.Logger,
+) *WebhookSender {
+	return &WebhookSender{
+		queue:        queue,
+		client:       client,
+		workers:      max(workers, 1),
+		pollInterval: pollInterval,
+		maxAttempts:  max(maxAttempts, 1),
+		retryDelay:   retryDelay,
+		lease:        client.Timeout + time.Minute,
+		log:          log,
+		now:          time.Now,
+	}
+}
+
+// SignWebhook возвращает подпись запроса: HMAC-SHA256 строки "<timestamp>.<body>"
+// на секрете подписки в шестнадцатеричном виде. Метка времени в подписи не дает
+// повторно отправить перехваченный запрос позже.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Run запускает обработчики и ждет их завершения после отмены ctx.
+func (t *WebhookSender) Run(ctx context.Context) {
+	var workers sync.WaitGroup
+	for range t.workers {
+		workers.Go(func() {
+			t.work(ctx)
+		})
+	}
+	workers.Wait()
+}
+
+func (t *WebhookSender) work(ctx context.Context) {
+	for {
+		sent, err := t.SendNext(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			t.log.Error("failed to send webhook", slog.String("error", err.Error()))
+		}
+		// пока очередь не пуста, следующая доставка берется сразу
+		if sent && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.pollInterval):
+		}
+	}
+}
+
+// SendNext захватывает одну доставку, отправляет ее и сохраняет итог.
+// sent равен false, если в очереди нет доставок, готовых к отправке.
+func (t *WebhookSender) SendNext(ctx context.Context) (sent bool, err error) {
+	const op = "internal/usecase/webhook_sender.WebhookSender.SendNext"
+
+	jobs, err := t.queue.ClaimWebhookDeliveries(ctx, 1, t.lease)
+	if err != nil {
+		return false, errors.Wrap(err, op)
+	}
+	if len(jobs) == 0 {
+		return false, nil
+	}
+	job := jobs[0]
+
+	result := t.send(ctx, &job)
+	// при остановке сервиса итог попытки не сохраняется: доставку повторят по
+	// истечении аренды, но попытка уже засчитана при захвате
+	if ctx.Err() != nil {
+		return true, nil
+	}
+
+	if err := t.queue.UpdateWebhookDelivery(ctx, job.DeliveryId, result); err != nil {
+		return true, errors.Wrap(err, op)
+	}
+	return true, nil
+}
+
+// send выполняет одну попытку доставки и определяет ее итог.
+func (t *WebhookSender) send(ctx context.Context, job *domain.WebhookJob) *domain.DeliveryResult {
+	statusCode, err := t.post(ctx, job)
+	if err == nil {
+		return &domain.DeliveryResult{
+			Status:     domain.DeliveryStatusDelivered,
+			StatusCode: statusCode,
+		}
+	}
+
+	result := &domain.DeliveryResult{
+		Status:     domain.DeliveryStatusFailed,
+		StatusCode: statusCode,
+		Error:      err.Error(),
+	}
+	if job.Attempt < t.maxAttempts {
+		result.Status = domain.DeliveryStatusPending
+		result.NextAttemptAt = t.now().Add(t.backoff(job.Attempt))
+	}
+	return result
+}
+
+// post отправляет подписанное событие; ответ со статусом вне 2xx считается ошибкой.
+func (t *WebhookSender) post(ctx context.Context, job *domain.WebhookJob) (statusCode *int, err error) {
+	timestamp := t.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.URL, bytes.NewReader(job.Payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "qna-webhooks")
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(job.DeliveryId, 10))
+	req.Header.Set(WebhookEventHeader, string(job.EventType))
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(job.Secret, timestamp, job.Payload))
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return &resp.StatusCode, nil
+}
+
+// backoff возвращает паузу перед попыткой attempt+1: retryDelay, удваиваемая
+// с каждой неудачной попыткой, но не больше maxWebhookRetryDelay.
+func (t *WebhookSender) backoff(attempt int) time.Duration {
+	delay := t.retryDelay
+	for i := 1; i < attempt && delay < maxWebhookRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxWebhookRetryDelay)
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookQueueStub отдает заранее заданные доставки и запоминает итоги попыток.
+type webhookQueueStub struct {
+	jobs    []domain.WebhookJob
+	results map[int64]*domain.DeliveryResult
+}
+
+func (t *webhookQueueStub) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookJob, error) {
+	n := min(limit, len(t.jobs))
+	jobs := t.jobs[:n]
+	t.jobs = t.jobs[n:]
+	return jobs, nil
+}
+
+func (t *webhookQueueStub) UpdateWebhookDelivery(ctx context.Context, deliveryId int64, result *domain.DeliveryResult) error {
+	t.results[deliveryId] = result
+	return nil
+}
+
+func statusCode(code int) *int {
+	return &code
+}
+
+func TestWebhookSender(t *testing.T) {
+	const secret = "0123456789abcdef"
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	payload := json.RawMessage(`{"type":"question.created","question_id":1,"occurred_at":"2026-10-17T12:00:00Z"}`)
+
+	// получатель проверяет подпись и отвечает статусом, заданным в пути;
+	// обработчик выполняется вне горутины теста, поэтому использует только assert
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		timestamp, err := strconv.ParseInt(r.Header.Get(WebhookTimestampHeader), 10, 64)
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		expected := "sha256=" + SignWebhook(secret, timestamp, body)
+		if !hmac.Equal([]byte(expected), []byte(r.Header.Get(WebhookSignatureHeader))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, string(domain.EventQuestionCreated), r.Header.Get(WebhookEventHeader))
+		assert.JSONEq(t, string(payload), string(body))
+
+		status, err := strconv.Atoi(r.URL.Path[1:])
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	defer receiver.Close()
+
+	testCases := []struct {
+		name     string
+		job      domain.WebhookJob
+		expected domain.DeliveryResult
+	}{
+		{
+			name: "Delivered",
+			job:  domain.WebhookJob{URL: receiver.URL + "/204", Secret: secret, Attempt: 1},
+			expected: domain.DeliveryResult{
+				Status:     domain.DeliveryStatusDelivered,
+				StatusCode: statusCode(http.StatusNoContent),
+			},
+		},
+		{
+			name: "Retried with exponential backoff",
+			job:  domain.WebhookJob{URL: receiver.URL + "/500", Secret: secret, Attempt: 3},
+			expected: domain.DeliveryResult{
+				Status:        domain.DeliveryStatusPending,
+				StatusCode:    statusCode(http.StatusInternalServerError),
+				Error:         "unexpected response status 500",
+				NextAttemptAt: now.Add(40 * time.Second),
+			},
+		},
+		{
+			name: "Wrong signature",
+			job:  domain.WebhookJob{URL: receiver.URL + "/204", Secret: "another-secret-value", Attempt: 1},
+			expected: domain.DeliveryResult{
+				Status:        domain.DeliveryStatusPending,
+				StatusCode:    statusCode(http.StatusUnauthorized),
+				Error:         "unexpected response status 401",
+				NextAttemptAt: now.Add(10 * time.Second),
+			},
+		},
+		{
+			name: "Failed after max attempts",
+			job:  domain.WebhookJob{URL: receiver.URL + "/503", Secret: secret, Attempt: 5},
+			expected: domain.DeliveryResult{
+				Status:     domain.DeliveryStatusFailed,
+				StatusCode: statusCode(http.StatusServiceUnavailable),
+				Error:      "unexpected response status 503",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.job.DeliveryId = 7
+			tt.job.EventType = domain.EventQuestionCreated
+			tt.job.Payload = payload
+			queue := &webhookQueueStub{
+				jobs:    []domain.WebhookJob{tt.job},
+				results: make(map[int64]*domain.DeliveryResult),
+			}
+
+			sender := NewWebhookSender(queue, receiver.Client(), 1, time.Millisecond, 5, 10*time.Second, slog.Default())
+			sender.now = func() time.Time { return now }
+
+			sent, err := sender.SendNext(context.Background())
+			require.NoError(t, err)
+			assert.True(t, sent)
+			require.Contains(t, queue.results, int64(7))
+			assert.Equal(t, tt.expected, *queue.results[7])
+
+			// очередь пуста
+			sent, err = sender.SendNext(context.Background())
+			require.NoError(t, err)
+			assert.False(t, sent)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Package migrations встраивает SQL-миграции в бинарный файл сервиса.
+package migrations
+
+import "embed"
+
+// Postgres - goose-миграции схемы PostgreSQL из каталога postgres.
+//
+//go:embed postgres/*.sql
+var Postgres embed.FS
+
+// PostgresDir - каталог миграций PostgreSQL внутри Postgres.
+const PostgresDir = "postgres"
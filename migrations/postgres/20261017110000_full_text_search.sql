@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Контент в основном на русском и английском языках, поэтому вектор
+-- строится сразу по двум конфигурациям.
+ALTER TABLE questions
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('russian', text) || to_tsvector('english', text)
+    ) STORED;
+
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('russian', text) || to_tsvector('english', text)
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_questions_search_vector ON questions USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_answers_search_vector ON answers USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_answers_search_vector;
+DROP INDEX IF EXISTS idx_questions_search_vector;
+ALTER TABLE answers DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE questions DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
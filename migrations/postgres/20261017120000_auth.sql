@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Имя пользователя используется как логин, поэтому должно быть уникальным.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password_hash TEXT;
+
+-- Раньше имена могли повторяться: самый ранний пользователь сохраняет имя,
+-- остальным к нему дописывается id (имя укорачивается, чтобы уложиться в 100 символов).
+UPDATE users u
+SET name = left(u.name, 63) || '-' || u.id::text
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY name ORDER BY created_at, id) AS n
+    FROM users
+) dup
+WHERE dup.id = u.id AND dup.n > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_name ON users (name);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    replaced_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_refresh_tokens_token_hash
+        UNIQUE (token_hash),
+
+    CONSTRAINT fk_refresh_tokens_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_refresh_tokens_replaced_by
+        FOREIGN KEY (replaced_by)
+        REFERENCES refresh_tokens (id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS refresh_tokens;
+DROP INDEX IF EXISTS idx_users_name;
+ALTER TABLE users DROP COLUMN IF EXISTS password_hash;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(20) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(20) NOT NULL,
+    permission VARCHAR(50) NOT NULL,
+
+    PRIMARY KEY (role, permission),
+
+    CONSTRAINT fk_role_permissions_role
+        FOREIGN KEY (role)
+        REFERENCES roles (name)
+        ON DELETE CASCADE
+);
+
+INSERT INTO roles (name, description) VALUES
+    ('user', 'Регулярный участник'),
+    ('moderator', 'Модератор контента'),
+    ('admin', 'Администратор')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('user', 'answer.delete.own'),
+    ('moderator', 'answer.delete.own'),
+    ('moderator', 'answer.delete.any'),
+    ('admin', 'answer.delete.own'),
+    ('admin', 'answer.delete.any'),
+    ('admin', 'question.delete'),
+    ('admin', 'user.delete')
+ON CONFLICT (role, permission) DO NOTHING;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user'
+    CONSTRAINT fk_users_role REFERENCES roles (name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
+-- +goose StatementEnd
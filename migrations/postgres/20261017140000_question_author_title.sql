@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Автор вопросов, созданных до появления авторства, неизвестен (user_id IS NULL).
+-- Удаление пользователя каскадно удаляет его вопросы, как и ответы.
+ALTER TABLE questions
+    ADD COLUMN IF NOT EXISTS user_id UUID,
+    ADD COLUMN IF NOT EXISTS title VARCHAR(200);
+
+UPDATE questions
+SET title = COALESCE(NULLIF(btrim(left(split_part(text, E'\n', 1), 200)), ''), 'Untitled')
+WHERE title IS NULL;
+
+ALTER TABLE questions
+    ALTER COLUMN title SET NOT NULL,
+    ADD CONSTRAINT chk_questions_title_not_blank CHECK (btrim(title) <> ''),
+    ADD CONSTRAINT fk_questions_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_questions_user_id ON questions (user_id);
+
+-- заголовок участвует в полнотекстовом поиске с большим весом
+DROP INDEX IF EXISTS idx_questions_search_vector;
+ALTER TABLE questions DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE questions
+    ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', title) || to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('russian', text) || to_tsvector('english', text), 'B')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_questions_search_vector ON questions USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_questions_search_vector;
+ALTER TABLE questions DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE questions
+    ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('russian', text) || to_tsvector('english', text)
+    ) STORED;
+CREATE INDEX IF NOT EXISTS idx_questions_search_vector ON questions USING GIN (search_vector);
+
+DROP INDEX IF EXISTS idx_questions_user_id;
+ALTER TABLE questions
+    DROP CONSTRAINT IF EXISTS fk_questions_user,
+    DROP CONSTRAINT IF EXISTS chk_questions_title_not_blank,
+    DROP COLUMN IF EXISTS title,
+    DROP COLUMN IF EXISTS user_id;
+-- +goose StatementEnd
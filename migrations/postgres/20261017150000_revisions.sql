@@ -0,0 +1,98 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Каждая правка текста вопроса или ответа сохраняется отдельной ревизией.
+-- Первая ревизия - исходный текст, записанный при создании.
+CREATE TABLE IF NOT EXISTS question_revisions (
+    id SERIAL PRIMARY KEY,
+    question_id INTEGER NOT NULL,
+    editor_id UUID,
+    title VARCHAR(200) NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_question_revisions_question
+        FOREIGN KEY (question_id)
+        REFERENCES questions (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_question_revisions_editor
+        FOREIGN KEY (editor_id)
+        REFERENCES users (id)
+        ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS answer_revisions (
+    id SERIAL PRIMARY KEY,
+    answer_id INTEGER NOT NULL,
+    editor_id UUID,
+    text TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_answer_revisions_answer
+        FOREIGN KEY (answer_id)
+        REFERENCES answers (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_answer_revisions_editor
+        FOREIGN KEY (editor_id)
+        REFERENCES users (id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_revisions_question_id ON question_revisions (question_id, id);
+CREATE INDEX IF NOT EXISTS idx_answer_revisions_answer_id ON answer_revisions (answer_id, id);
+
+-- ревизии неизменяемы: разрешены только вставка, каскадное удаление и
+-- обнуление автора при окончательном удалении пользователя (ON DELETE SET NULL)
+CREATE OR REPLACE FUNCTION forbid_revision_update() RETURNS trigger AS $$
+BEGIN
+    IF NEW.editor_id IS NULL AND to_jsonb(NEW) - 'editor_id' = to_jsonb(OLD) - 'editor_id' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'revisions are immutable' USING ERRCODE = 'check_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_question_revisions_immutable
+    BEFORE UPDATE ON question_revisions
+    FOR EACH ROW EXECUTE FUNCTION forbid_revision_update();
+
+CREATE TRIGGER trg_answer_revisions_immutable
+    BEFORE UPDATE ON answer_revisions
+    FOR EACH ROW EXECUTE FUNCTION forbid_revision_update();
+
+INSERT INTO question_revisions (question_id, editor_id, title, text, created_at)
+SELECT id, user_id, title, text, created_at FROM questions;
+
+INSERT INTO answer_revisions (answer_id, editor_id, text, created_at)
+SELECT id, user_id, text, created_at FROM answers;
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('user', 'question.edit.own'),
+    ('user', 'answer.edit.own'),
+    ('user', 'user.edit.own'),
+    ('moderator', 'question.edit.own'),
+    ('moderator', 'question.edit.any'),
+    ('moderator', 'answer.edit.own'),
+    ('moderator', 'answer.edit.any'),
+    ('moderator', 'user.edit.own'),
+    ('admin', 'question.edit.own'),
+    ('admin', 'question.edit.any'),
+    ('admin', 'answer.edit.own'),
+    ('admin', 'answer.edit.any'),
+    ('admin', 'user.edit.own'),
+    ('admin', 'user.edit.any')
+ON CONFLICT (role, permission) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN (
+    'question.edit.own', 'question.edit.any',
+    'answer.edit.own', 'answer.edit.any',
+    'user.edit.own', 'user.edit.any'
+);
+DROP TABLE IF EXISTS answer_revisions;
+DROP TABLE IF EXISTS question_revisions;
+DROP FUNCTION IF EXISTS forbid_revision_update();
+-- +goose StatementEnd
@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Один голос пользователя на публикацию: +1 или -1. Рейтинг хранится
+-- в самой публикации и пересчитывается в той же транзакции, что и голос.
+CREATE TABLE IF NOT EXISTS question_votes (
+    question_id INTEGER NOT NULL,
+    user_id UUID NOT NULL,
+    value SMALLINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (question_id, user_id),
+    CONSTRAINT chk_question_votes_value CHECK (value IN (-1, 1)),
+
+    CONSTRAINT fk_question_votes_question
+        FOREIGN KEY (question_id)
+        REFERENCES questions (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_question_votes_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS answer_votes (
+    answer_id INTEGER NOT NULL,
+    user_id UUID NOT NULL,
+    value SMALLINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (answer_id, user_id),
+    CONSTRAINT chk_answer_votes_value CHECK (value IN (-1, 1)),
+
+    CONSTRAINT fk_answer_votes_answer
+        FOREIGN KEY (answer_id)
+        REFERENCES answers (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_answer_votes_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_votes_user_id ON question_votes (user_id);
+CREATE INDEX IF NOT EXISTS idx_answer_votes_user_id ON answer_votes (user_id);
+
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS score INTEGER NOT NULL DEFAULT 0;
+
+-- ответы вопроса выдаются по убыванию рейтинга, затем по дате
+CREATE INDEX IF NOT EXISTS idx_answers_question_id_score
+    ON answers (question_id, score DESC, created_at ASC, id ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_answers_question_id_score;
+ALTER TABLE answers DROP COLUMN IF EXISTS score;
+ALTER TABLE questions DROP COLUMN IF EXISTS score;
+DROP TABLE IF EXISTS answer_votes;
+DROP TABLE IF EXISTS question_votes;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Жизненный цикл вопроса: open -> answered (принят ответ) -> closed / locked.
+-- Допустимые переходы проверяются в usecase.QNACrud.
+ALTER TABLE questions
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'open',
+    ADD COLUMN IF NOT EXISTS accepted_answer_id INTEGER,
+    ADD CONSTRAINT chk_questions_status CHECK (status IN ('open', 'answered', 'closed', 'locked')),
+    ADD CONSTRAINT fk_questions_accepted_answer
+        FOREIGN KEY (accepted_answer_id)
+        REFERENCES answers (id)
+        ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_questions_status_created_at_id ON questions (status, created_at, id);
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('user', 'question.close.own'),
+    ('moderator', 'question.close.own'),
+    ('moderator', 'question.close.any'),
+    ('moderator', 'question.lock'),
+    ('admin', 'question.close.own'),
+    ('admin', 'question.close.any'),
+    ('admin', 'question.lock')
+ON CONFLICT (role, permission) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN (
+    'question.close.own', 'question.close.any', 'question.lock'
+);
+DROP INDEX IF EXISTS idx_questions_status_created_at_id;
+ALTER TABLE questions
+    DROP CONSTRAINT IF EXISTS fk_questions_accepted_answer,
+    DROP CONSTRAINT IF EXISTS chk_questions_status,
+    DROP COLUMN IF EXISTS accepted_answer_id,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Имена тегов хранятся нормализованными (нижний регистр, без пробелов).
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(35) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT uq_tags_name UNIQUE (name),
+    CONSTRAINT chk_tags_name CHECK (name ~ '^[a-z0-9][a-z0-9+#.-]*$')
+);
+
+CREATE TABLE IF NOT EXISTS question_tags (
+    question_id INTEGER NOT NULL,
+    tag_id INTEGER NOT NULL,
+
+    PRIMARY KEY (question_id, tag_id),
+
+    CONSTRAINT fk_question_tags_question
+        FOREIGN KEY (question_id)
+        REFERENCES questions (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_question_tags_tag
+        FOREIGN KEY (tag_id)
+        REFERENCES tags (id)
+        ON DELETE CASCADE
+);
+
+-- выборка вопросов по тегу
+CREATE INDEX IF NOT EXISTS idx_question_tags_tag_id ON question_tags (tag_id, question_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS question_tags;
+DROP TABLE IF EXISTS tags;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Комментарий относится ровно к одному родителю: вопросу или ответу.
+-- Удаление родителя каскадно удаляет комментарии, как и ответы вопроса.
+CREATE TABLE IF NOT EXISTS comments (
+    id SERIAL PRIMARY KEY,
+    question_id INTEGER,
+    answer_id INTEGER,
+    user_id UUID NOT NULL,
+    text VARCHAR(600) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_comments_parent CHECK (num_nonnulls(question_id, answer_id) = 1),
+    CONSTRAINT chk_comments_text_not_blank CHECK (btrim(text) <> ''),
+
+    CONSTRAINT fk_comments_question
+        FOREIGN KEY (question_id)
+        REFERENCES questions (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_comments_answer
+        FOREIGN KEY (answer_id)
+        REFERENCES answers (id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_comments_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_question_id ON comments (question_id, created_at, id) WHERE question_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_answer_id ON comments (answer_id, created_at, id) WHERE answer_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_user_id ON comments (user_id);
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('user', 'comment.delete.own'),
+    ('moderator', 'comment.delete.own'),
+    ('moderator', 'comment.delete.any'),
+    ('admin', 'comment.delete.own'),
+    ('admin', 'comment.delete.any')
+ON CONFLICT (role, permission) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN ('comment.delete.own', 'comment.delete.any');
+DROP TABLE IF EXISTS comments;
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Служебный пользователь-заглушка: при анонимизации ему передаются публикации
+-- удаляемого пользователя. Войти под ним нельзя - у него нет пароля.
+-- Имя заглушки занимает уникальный индекс, поэтому зарегистрироваться под ним
+-- нельзя. Настоящего пользователя с этим именем миграция не переименовывает
+-- (он входит по имени), а останавливается, чтобы его переименовали вручную.
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM users
+        WHERE name = 'deleted user' AND id <> '00000000-0000-0000-0000-000000000000'
+    ) THEN
+        RAISE EXCEPTION 'user name "deleted user" is reserved for the deleted user placeholder'
+            USING HINT = 'Rename the existing user and rerun the migration.';
+    END IF;
+END;
+$$;
+
+INSERT INTO users (id, name, password_hash, role)
+VALUES ('00000000-0000-0000-0000-000000000000', 'deleted user', NULL, 'user')
+ON CONFLICT DO NOTHING;
+
+-- Ответы окончательно удаляемого пользователя, оставшиеся в базе,
+-- переходят к заглушке, а не удаляются каскадно.
+ALTER TABLE answers
+    ALTER COLUMN user_id SET DEFAULT '00000000-0000-0000-0000-000000000000',
+    DROP CONSTRAINT IF EXISTS fk_answers_user,
+    ADD CONSTRAINT fk_answers_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE SET DEFAULT;
+
+-- При анонимизации автор правки заменяется заглушкой,
+-- остальные поля ревизии по-прежнему неизменяемы.
+CREATE OR REPLACE FUNCTION forbid_revision_update() RETURNS trigger AS $$
+BEGIN
+    IF to_jsonb(NEW) - 'editor_id' IS DISTINCT FROM to_jsonb(OLD) - 'editor_id' THEN
+        RAISE EXCEPTION 'revisions are immutable' USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION forbid_revision_update() RETURNS trigger AS $$
+BEGIN
+    IF NEW.editor_id IS NULL AND to_jsonb(NEW) - 'editor_id' = to_jsonb(OLD) - 'editor_id' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'revisions are immutable' USING ERRCODE = 'check_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE answers
+    ALTER COLUMN user_id DROP DEFAULT,
+    DROP CONSTRAINT IF EXISTS fk_answers_user,
+    ADD CONSTRAINT fk_answers_user
+        FOREIGN KEY (user_id)
+        REFERENCES users (id)
+        ON DELETE CASCADE;
+
+-- заглушка не удаляется: на нее ссылаются анонимизированные публикации
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Сквозная нумерация доменных событий для всех экземпляров сервиса:
+-- Id события из уведомления qna_events используется как id потока SSE.
+CREATE SEQUENCE IF NOT EXISTS event_ids;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP SEQUENCE IF EXISTS event_ids;
+-- +goose StatementEnd
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+// CreateAnswerToQuestion добавляет ответ с Text от пользователя UserId к вопросу QuestionId.
+func (c *Client) CreateAnswerToQuestion(ctx context.Context, answer *Answer) (answerId int, err error) {
+	const op = "pkg/client/answers.Client.CreateAnswerToQuestion"
+
+	var resp response.CreateAnswerToQuestionResponse
+	_, err = c.do(ctx, &call{
+		method: http.MethodPost,
+		path:   "/questions/" + strconv.Itoa(answer.QuestionId) + "/answers/",
+		body:   request.CreateAnswerToQuestionRequest{UserId: answer.UserId, Text: answer.Text},
+	}, &resp)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	return resp.AnswerId, nil
+}
+
+func (c *Client) GetAnswer(ctx context.Context, answerId int) (*Answer, error) {
+	const op = "pkg/client/answers.Client.GetAnswer"
+
+	var answer Answer
+	_, err := c.do(ctx, &call{
+		method: http.MethodGet,
+		path:   "/answers/" + strconv.Itoa(answerId),
+	}, &answer)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &answer, nil
+}
+
+func (c *Client) UpdateAnswer(ctx context.Context, answerId int, patch *AnswerPatch) (*Answer, error) {
+	const op = "pkg/client/answers.Client.UpdateAnswer"
+
+	var answer Answer
+	_, err := c.do(ctx, &call{
+		method:      http.MethodPatch,
+		path:        "/answers/" + strconv.Itoa(answerId),
+		contentType: contentTypeMergePatch,
+		body:        request.PatchAnswerRequest{Text: optional(patch.Text)},
+	}, &answer)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &answer, nil
+}
+
+func (c *Client) DeleteAnswer(ctx context.Context, answerId int) error {
+	const op = "pkg/client/answers.Client.DeleteAnswer"
+
+	_, err := c.do(ctx, &call{
+		method: http.MethodDelete,
+		path:   "/answers/" + strconv.Itoa(answerId),
+	}, nil)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/pkg/errors"
+)
+
+// Login выполняет вход и использует полученный access-токен в следующих запросах.
+func (c *Client) Login(ctx context.Context, userName string, password string) (*Tokens, error) {
+	const op = "pkg/client/auth.Client.Login"
+
+	var tokens Tokens
+	_, err := c.do(ctx, &call{
+		method: http.MethodPost,
+		path:   "/auth/login",
+		body:   request.LoginRequest{Name: userName, Password: password},
+	}, &tokens)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	c.SetAccessToken(tokens.AccessToken)
+	return &tokens, nil
+}
+
+// Refresh обменивает refresh-токен на новую пару токенов и использует новый
+// access-токен в следующих запросах.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	const op = "pkg/client/auth.Client.Refresh"
+
+	var tokens Tokens
+	_, err := c.do(ctx, &call{
+		method: http.MethodPost,
+		path:   "/auth/refresh",
+		body:   request.RefreshTokenRequest{RefreshToken: refreshToken},
+	}, &tokens)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	c.SetAccessToken(tokens.AccessToken)
+	return &tokens, nil
+}
+
+// Logout отзывает refresh-токен; access-токен клиента сбрасывается.
+func (c *Client) Logout(ctx context.Context, refreshToken string) error {
+	const op = "pkg/client/auth.Client.Logout"
+
+	_, err := c.do(ctx, &call{
+		method: http.MethodPost,
+		path:   "/auth/logout",
+		body:   request.RefreshTokenRequest{RefreshToken: refreshToken},
+	}, nil)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	c.SetAccessToken("")
+	return nil
+}
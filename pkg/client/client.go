@@ -0,0 +1,236 @@
+// Package client - клиент HTTP API сервиса вопросов и ответов.
+//
+// Методы повторяют QNADispatcher: пользователи, вопросы и ответы, а также вход
+// для получения access-токена. Ответ сервиса раскладывается из конверта
+// response.Response; ошибка API возвращается как *Error и сопоставляется с
+// ErrNotFound, ErrConflict и другими ошибками через errors.Is.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 2
+	defaultRetryDelay = 200 * time.Millisecond
+	// maxRetryDelay ограничивает экспоненциальный рост паузы между повторами
+	maxRetryDelay = 5 * time.Second
+
+	contentTypeJSON       = "application/json"
+	contentTypeMergePatch = "application/merge-patch+json"
+)
+
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	maxRetries int
+	retryDelay time.Duration
+
+	mu          sync.RWMutex
+	accessToken string
+}
+
+type Option func(*Client)
+
+// WithHTTPClient задает HTTP-клиент, например с собственным транспортом или таймаутом.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithAccessToken задает access-токен для операций, требующих аутентификации.
+func WithAccessToken(accessToken string) Option {
+	return func(c *Client) {
+		c.accessToken = accessToken
+	}
+}
+
+// WithRetries задает число повторов идемпотентных запросов (GET, PUT, DELETE)
+// при сетевой ошибке или ответе 5xx и паузу перед первым повтором; каждая
+// следующая пауза вдвое длиннее. maxRetries = 0 отключает повторы.
+func WithRetries(maxRetries int, delay time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = max(maxRetries, 0)
+		c.retryDelay = delay
+	}
+}
+
+// New создает клиент API, доступного по адресу baseURL (например, http://localhost:8080).
+func New(baseURL string, opts ...Option) (*Client, error) {
+	const op = "pkg/client/client.New"
+
+	target, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	if target.Scheme == "" || target.Host == "" {
+		return nil, errors.Errorf("%s: base URL must be absolute", op)
+	}
+
+	c := &Client{
+		baseURL:    target,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		maxRetries: defaultMaxRetries,
+		retryDelay: defaultRetryDelay,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// SetAccessToken заменяет access-токен для последующих запросов.
+func (c *Client) SetAccessToken(accessToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accessToken = accessToken
+}
+
+func (c *Client) token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.accessToken
+}
+
+// envelope - конверт ответа API; data раскладывается отдельно в тип операции.
+type envelope struct {
+	Status string          `json:"status"`
+	Error  *response.Error `json:"error"`
+	Data   json.RawMessage `json:"data"`
+	Meta   *response.Meta  `json:"meta"`
+}
+
+// call - HTTP-запрос к API.
+type call struct {
+	method      string
+	path        string
+	query       url.Values
+	contentType string
+	body        any
+}
+
+// do выполняет запрос, повторяя идемпотентные запросы при временных сбоях,
+// раскладывает поле data ответа в out и возвращает метаданные пагинации.
+func (c *Client) do(ctx context.Context, req *call, out any) (*response.Meta, error) {
+	var payload []byte
+	if req.body != nil {
+		var err error
+		payload, err = json.Marshal(req.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	target := c.baseURL.JoinPath(req.path)
+	target.RawQuery = req.query.Encode()
+
+	retries := 0
+	if idempotent(req.method) {
+		retries = c.maxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		meta, err := c.send(ctx, req, target.String(), payload, out)
+		if err == nil || attempt >= retries || !retryable(err) || ctx.Err() != nil {
+			return meta, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.backoff(attempt)):
+		}
+	}
+}
+
+// send выполняет одну попытку запроса.
+func (c *Client) send(ctx context.Context, req *call, target string, payload []byte, out any) (*response.Meta, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", contentTypeJSON)
+	if payload != nil {
+		contentType := req.contentType
+		if contentType == "" {
+			contentType = contentTypeJSON
+		}
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if accessToken := c.token(); accessToken != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil && !errors.Is(err, io.EOF) {
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return nil, &Error{StatusCode: resp.StatusCode}
+		}
+		return nil, errors.Wrap(err, "failed to decode response")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		if env.Error != nil {
+			apiErr.Code, apiErr.Desc = env.Error.Code, env.Error.Desc
+		}
+		return nil, apiErr
+	}
+
+	if out != nil && len(env.Data) > 0 {
+		if err := json.Unmarshal(env.Data, out); err != nil {
+			return nil, errors.Wrap(err, "failed to decode response data")
+		}
+	}
+	return env.Meta, nil
+}
+
+// backoff возвращает паузу перед повтором после попытки attempt (с нуля).
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.retryDelay
+	for i := 0; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable сообщает, что запрос может пройти при повторе: сетевая ошибка или 5xx.
+func retryable(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	// ошибки соединения http.Client возвращает как *url.Error
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
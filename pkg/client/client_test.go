@@ -0,0 +1,280 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/health"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/mocks"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/token"
+)
+
+const testUserId = "f47ac10b-58cc-4372-a567-0e02b2c3de91"
+
+// testServer - API на настоящих обработчиках rest с моками сервисов.
+type testServer struct {
+	url         string
+	service     *mocks.MockQNADispatcher
+	auth        *mocks.MockAuthDispatcher
+	accessToken string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	tokens := token.NewManager([]byte("secret"), time.Minute)
+	accessToken, _, err := tokens.IssueAccessToken(&domain.Principal{UserId: testUserId})
+	require.NoError(t, err)
+
+	service := mocks.NewMockQNADispatcher(t)
+	auth := mocks.NewMockAuthDispatcher(t)
+	addr := ""
+	server := rest.NewServer(rest.ServerDeps{
+		Log:             slog.New(slog.DiscardHandler),
+		Service:         service,
+		Auth:            auth,
+		Tokens:          tokens,
+		Addr:            &addr,
+		ShutdownTimeout: time.Second,
+		Health:          health.NewRegistry(time.Second),
+	})
+
+	httpServer := httptest.NewServer(server.Handler())
+	t.Cleanup(httpServer.Close)
+
+	return &testServer{url: httpServer.URL, service: service, auth: auth, accessToken: accessToken}
+}
+
+func (t *testServer) client(tb testing.TB, opts ...Option) *Client {
+	opts = append([]Option{WithAccessToken(t.accessToken), WithRetries(2, time.Millisecond)}, opts...)
+	c, err := New(t.url, opts...)
+	require.NoError(tb, err)
+	return c
+}
+
+func TestQuestions(t *testing.T) {
+	server := newTestServer(t)
+	c := server.client(t)
+	ctx := context.Background()
+
+	question := domain.Question{
+		Id:        7,
+		UserId:    testUserId,
+		Title:     "title",
+		Text:      "text",
+		Tags:      []string{"go"},
+		Status:    domain.QuestionStatusOpen,
+		CreatedAt: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+	}
+	answers := []domain.Answer{{Id: 1, QuestionId: 7, UserId: testUserId, Text: "answer"}}
+
+	server.service.On("CreateQuestion", mock.Anything, &domain.Question{Title: "title", Text: "text", Tags: []string{"go"}}).
+		Return(7, nil).Once()
+	questionId, err := c.CreateQuestion(ctx, &Question{Title: "title", Text: "text", Tags: []string{"go"}})
+	require.NoError(t, err)
+	assert.Equal(t, 7, questionId)
+
+	server.service.On("GetQuestionAndAnswers", mock.Anything, 7).Return(&question, &answers, nil).Once()
+	gotQuestion, gotAnswers, err := c.GetQuestionAndAnswers(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, question, *gotQuestion)
+	assert.Equal(t, answers, gotAnswers)
+
+	// поля патча, равные nil, не попадают в документ, пустой список снимает теги
+	title := "new title"
+	server.service.On("UpdateQuestion", mock.Anything, 7, &domain.QuestionPatch{Title: &title, Tags: &[]string{}}).
+		Return(&question, nil).Once()
+	_, err = c.UpdateQuestion(ctx, 7, &QuestionPatch{Title: &title, Tags: &[]string{}})
+	require.NoError(t, err)
+
+	server.service.On("DeleteQuestionAndAnswers", mock.Anything, 7).Return(nil).Once()
+	require.NoError(t, c.DeleteQuestionAndAnswers(ctx, 7))
+}
+
+func TestErrors(t *testing.T) {
+	server := newTestServer(t)
+
+	testCases := []struct {
+		name         string
+		client       *Client
+		setupMock    func(*mocks.MockQNADispatcher)
+		call         func(c *Client) error
+		expected     error
+		expectedCode string
+		expectedHTTP int
+	}{
+		{
+			name:   "Not found",
+			client: server.client(t),
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("GetAnswer", mock.Anything, 1).Return(nil, domain.ErrNotFound).Once()
+			},
+			call: func(c *Client) error {
+				_, err := c.GetAnswer(context.Background(), 1)
+				return err
+			},
+			expected:     ErrNotFound,
+			expectedCode: CodeNotFound,
+			expectedHTTP: 404,
+		},
+		{
+			name:   "Invalid id",
+			client: server.client(t),
+			call: func(c *Client) error {
+				_, err := c.GetAnswer(context.Background(), 0)
+				return err
+			},
+			expected:     ErrValidation,
+			expectedCode: CodeValidationFailed,
+			expectedHTTP: 400,
+		},
+		{
+			name:   "Conflict",
+			client: server.client(t),
+			setupMock: func(mockDispatcher *mocks.MockQNADispatcher) {
+				mockDispatcher.On("DeleteAnswer", mock.Anything, 1).Return(domain.ErrConflict).Once()
+			},
+			call: func(c *Client) error {
+				return c.DeleteAnswer(context.Background(), 1)
+			},
+			expected:     ErrConflict,
+			expectedCode: CodeConflict,
+			expectedHTTP: 409,
+		},
+		{
+			name:   "Missing access token",
+			client: server.client(t, WithAccessToken("")),
+			call: func(c *Client) error {
+				return c.DeleteAnswer(context.Background(), 1)
+			},
+			expected:     ErrUnauthorized,
+			expectedCode: CodeMissingAuthHeader,
+			expectedHTTP: 401,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupMock != nil {
+				tt.setupMock(server.service)
+			}
+
+			err := tt.call(tt.client)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expected)
+
+			var apiErr *Error
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.expectedCode, apiErr.Code)
+			assert.Equal(t, tt.expectedHTTP, apiErr.StatusCode)
+		})
+	}
+}
+
+func TestRetries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Idempotent request is retried", func(t *testing.T) {
+		server := newTestServer(t)
+		answer := domain.Answer{Id: 1, QuestionId: 7, UserId: testUserId, Text: "answer"}
+		server.service.On("GetAnswer", mock.Anything, 1).Return(nil, errors.New("connection reset")).Once()
+		server.service.On("GetAnswer", mock.Anything, 1).Return(&answer, nil).Once()
+
+		got, err := server.client(t).GetAnswer(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, answer, *got)
+	})
+
+	t.Run("Retries are limited", func(t *testing.T) {
+		server := newTestServer(t)
+		server.service.On("GetAnswer", mock.Anything, 1).Return(nil, errors.New("connection reset")).Times(3)
+
+		_, err := server.client(t).GetAnswer(ctx, 1)
+		assert.ErrorIs(t, err, ErrInternal)
+		server.service.AssertNumberOfCalls(t, "GetAnswer", 3)
+	})
+
+	t.Run("Create is not retried", func(t *testing.T) {
+		server := newTestServer(t)
+		server.service.On("CreateQuestion", mock.Anything, mock.Anything).Return(0, errors.New("connection reset")).Once()
+
+		_, err := server.client(t).CreateQuestion(ctx, &Question{Title: "title", Text: "text"})
+		assert.ErrorIs(t, err, ErrInternal)
+		server.service.AssertNumberOfCalls(t, "CreateQuestion", 1)
+	})
+
+	t.Run("Context is canceled during backoff", func(t *testing.T) {
+		server := newTestServer(t)
+		server.service.On("GetAnswer", mock.Anything, 1).Return(nil, errors.New("connection reset")).Once()
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err := server.client(t, WithRetries(2, time.Hour)).GetAnswer(ctx, 1)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestQuestionsPagination(t *testing.T) {
+	server := newTestServer(t)
+	c := server.client(t)
+
+	createdAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	firstPage := []domain.Question{{Id: 1, CreatedAt: createdAt}, {Id: 2, CreatedAt: createdAt}}
+	lastPage := []domain.Question{{Id: 3, CreatedAt: createdAt}}
+	filter := &domain.QuestionFilter{Tags: []string{"go", "sql"}, TagMatch: domain.TagMatchAny}
+
+	server.service.On("GetQuestions", mock.Anything, mock.MatchedBy(func(params *domain.ListParams) bool {
+		return params.Limit == 2 && params.After == nil
+	}), filter).Return(&firstPage, &domain.Page{NextCursor: &domain.Cursor{CreatedAt: createdAt, Id: "2"}}, nil).Once()
+	server.service.On("GetQuestions", mock.Anything, mock.MatchedBy(func(params *domain.ListParams) bool {
+		return params.Limit == 2 && params.After != nil && params.After.Id == "2"
+	}), filter).Return(&lastPage, &domain.Page{}, nil).Once()
+
+	var ids []int
+	for question, err := range c.Questions(context.Background(), &ListParams{Limit: 2}, filter) {
+		require.NoError(t, err)
+		ids = append(ids, question.Id)
+	}
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestLogin(t *testing.T) {
+	server := newTestServer(t)
+	c := server.client(t, WithAccessToken(""))
+	ctx := context.Background()
+
+	userName, password := "user", "password"
+	server.auth.On("Login", mock.Anything, &userName, &password).Return(&domain.TokenPair{
+		AccessToken:           server.accessToken,
+		AccessTokenExpiresAt:  time.Now().Add(time.Minute),
+		RefreshToken:          "refresh",
+		RefreshTokenExpiresAt: time.Now().Add(time.Hour),
+	}, nil).Once()
+
+	tokens, err := c.Login(ctx, userName, password)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", tokens.RefreshToken)
+
+	// последующие запросы идут с полученным access-токеном
+	server.service.On("DeleteAnswer", mock.Anything, 1).Return(nil).Once()
+	require.NoError(t, c.DeleteAnswer(ctx, 1))
+}
+
+func TestSearch(t *testing.T) {
+	server := newTestServer(t)
+	hits := []domain.SearchHit{{Kind: "answer", Id: 5, QuestionId: 1, Rank: 0.5, Snippet: "use <b>channels</b>"}}
+	server.service.On("Search", mock.Anything, &domain.SearchQuery{Text: "channels", Language: "english", Limit: 5, Offset: 0}).
+		Return(&hits, nil).Once()
+
+	got, err := server.client(t).Search(context.Background(), &SearchQuery{Text: "channels", Language: "english", Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, hits, got)
+}
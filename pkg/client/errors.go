@@ -0,0 +1,74 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+// Коды ошибок в поле error.code ответа API.
+const (
+	CodeInvalidToken        = response.ErrCodeInvalidToken
+	CodeMissingAuthHeader   = response.ErrCodeMissingAuthHeader
+	CodeValidationFailed    = response.ErrCodeValidationFailed
+	CodeJsonParsingFailed   = response.ErrCodeJsonParsingFailed
+	CodeInternalServerError = response.ErrCodeInternalServerError
+	CodeUnauthorized        = response.ErrCodeUnauthorized
+	CodeNotFound            = response.ErrCodeNotFound
+	CodeConflict            = response.ErrCodeConflict
+	CodeReferenceNotFound   = response.ErrCodeReferenceNotFound
+	CodeForbidden           = response.ErrCodeForbidden
+	CodeUnsupportedMedia    = response.ErrCodeUnsupportedMedia
+)
+
+// Ошибки, с которыми *Error сопоставляется через errors.Is по коду ошибки.
+var (
+	ErrValidation        = errors.New("validation failed")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrForbidden         = errors.New("forbidden")
+	ErrNotFound          = errors.New("not found")
+	ErrConflict          = errors.New("conflict")
+	ErrReferenceNotFound = errors.New("reference not found")
+	ErrUnsupportedMedia  = errors.New("unsupported media type")
+	ErrInternal          = errors.New("internal server error")
+)
+
+var codeErrors = map[string]error{
+	CodeInvalidToken:        ErrUnauthorized,
+	CodeMissingAuthHeader:   ErrUnauthorized,
+	CodeUnauthorized:        ErrUnauthorized,
+	CodeValidationFailed:    ErrValidation,
+	CodeJsonParsingFailed:   ErrValidation,
+	CodeForbidden:           ErrForbidden,
+	CodeNotFound:            ErrNotFound,
+	CodeConflict:            ErrConflict,
+	CodeReferenceNotFound:   ErrReferenceNotFound,
+	CodeUnsupportedMedia:    ErrUnsupportedMedia,
+	CodeInternalServerError: ErrInternal,
+}
+
+// Error - ошибка, возвращенная API. Code пуст, если ответ не содержит
+// конверта с ошибкой (например, ответ прокси).
+type Error struct {
+	StatusCode int
+	Code       string
+	Desc       string
+}
+
+func (e *Error) Error() string {
+	code := e.Code
+	if code == "" {
+		code = http.StatusText(e.StatusCode)
+	}
+	if e.Desc == "" {
+		return fmt.Sprintf("qna api: %d %s", e.StatusCode, code)
+	}
+	return fmt.Sprintf("qna api: %d %s: %s", e.StatusCode, code, e.Desc)
+}
+
+func (e *Error) Is(target error) bool {
+	sentinel, ok := codeErrors[e.Code]
+	return ok && sentinel == target
+}
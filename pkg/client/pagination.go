@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ListParams - параметры страницы списка. Нулевые значения не передаются,
+// и сервер подставляет значения по умолчанию.
+type ListParams struct {
+	Limit int
+	Order SortOrder
+	// Cursor - курсор страницы из Page.NextCursor предыдущего запроса
+	Cursor        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+func (p *ListParams) values() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Order != "" {
+		query.Set("order", string(p.Order))
+	}
+	if p.Cursor != "" {
+		query.Set("cursor", p.Cursor)
+	}
+	if !p.CreatedAfter.IsZero() {
+		query.Set("created_after", p.CreatedAfter.Format(time.RFC3339))
+	}
+	if !p.CreatedBefore.IsZero() {
+		query.Set("created_before", p.CreatedBefore.Format(time.RFC3339))
+	}
+	return query
+}
+
+// Page - страница списка; NextCursor пуст на последней странице.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+}
+
+// paginate обходит страницы списка, пока сервер возвращает курсор следующей
+// страницы. Ошибка запроса передается последним элементом последовательности.
+func paginate[T any](
+	ctx context.Context,
+	params *ListParams,
+	fetch func(ctx context.Context, params *ListParams) (*Page[T], error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var next ListParams
+		if params != nil {
+			next = *params
+		}
+		for {
+			page, err := fetch(ctx, &next)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if page.NextCursor == "" {
+				return
+			}
+			next.Cursor = page.NextCursor
+		}
+	}
+}
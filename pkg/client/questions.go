@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+// GetQuestions возвращает одну страницу списка вопросов, отобранных filter.
+func (c *Client) GetQuestions(ctx context.Context, params *ListParams, filter *QuestionFilter) (*Page[Question], error) {
+	const op = "pkg/client/questions.Client.GetQuestions"
+
+	query := params.values()
+	if filter != nil {
+		for _, status := range filter.Statuses {
+			query.Add("status", string(status))
+		}
+		for _, tag := range filter.Tags {
+			query.Add("tag", tag)
+		}
+		if filter.TagMatch != "" {
+			query.Set("tag_match", string(filter.TagMatch))
+		}
+	}
+
+	var questions []Question
+	meta, err := c.do(ctx, &call{
+		method: http.MethodGet,
+		path:   "/questions/",
+		query:  query,
+	}, &questions)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &Page[Question]{Items: questions, NextCursor: nextCursor(meta)}, nil
+}
+
+// Questions обходит все вопросы, отобранные filter, начиная со страницы params.
+func (c *Client) Questions(ctx context.Context, params *ListParams, filter *QuestionFilter) iter.Seq2[Question, error] {
+	return paginate(ctx, params, func(ctx context.Context, params *ListParams) (*Page[Question], error) {
+		return c.GetQuestions(ctx, params, filter)
+	})
+}
+
+// CreateQuestion создает вопрос из Title, Text и Tags; автором становится
+// владелец access-токена.
+func (c *Client) CreateQuestion(ctx context.Context, question *Question) (questionId int, err error) {
+	const op = "pkg/client/questions.Client.CreateQuestion"
+
+	var resp response.CreateQuestionResponse
+	_, err = c.do(ctx, &call{
+		method: http.MethodPost,
+		path:   "/questions/",
+		body: request.CreateQuestionRequest{
+			Title: question.Title,
+			Text:  question.Text,
+			Tags:  question.Tags,
+		},
+	}, &resp)
+	if err != nil {
+		return 0, errors.Wrap(err, op)
+	}
+	return resp.QuestionId, nil
+}
+
+func (c *Client) GetQuestionAndAnswers(ctx context.Context, questionId int) (*Question, []Answer, error) {
+	const op = "pkg/client/questions.Client.GetQuestionAndAnswers"
+
+	var resp response.GetQuestionAndAnswersResponse
+	_, err := c.do(ctx, &call{
+		method: http.MethodGet,
+		path:   "/questions/" + strconv.Itoa(questionId),
+	}, &resp)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, op)
+	}
+	return &resp.Question, resp.Answers, nil
+}
+
+func (c *Client) UpdateQuestion(ctx context.Context, questionId int, patch *QuestionPatch) (*Question, error) {
+	const op = "pkg/client/questions.Client.UpdateQuestion"
+
+	var question Question
+	_, err := c.do(ctx, &call{
+		method:      http.MethodPatch,
+		path:        "/questions/" + strconv.Itoa(questionId),
+		contentType: contentTypeMergePatch,
+		body: request.PatchQuestionRequest{
+			Title: optional(patch.Title),
+			Text:  optional(patch.Text),
+			Tags:  optional(patch.Tags),
+		},
+	}, &question)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &question, nil
+}
+
+// DeleteQuestionAndAnswers удаляет вопрос вместе с ответами в корзину.
+func (c *Client) DeleteQuestionAndAnswers(ctx context.Context, questionId int) error {
+	const op = "pkg/client/questions.Client.DeleteQuestionAndAnswers"
+
+	_, err := c.do(ctx, &call{
+		method: http.MethodDelete,
+		path:   "/questions/" + strconv.Itoa(questionId),
+	}, nil)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
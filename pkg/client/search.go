@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Search ищет вопросы и ответы по тексту запроса.
+func (c *Client) Search(ctx context.Context, query *SearchQuery) ([]SearchHit, error) {
+	const op = "pkg/client/search.Client.Search"
+
+	values := url.Values{"q": {query.Text}}
+	if query.Language != "" {
+		values.Set("lang", query.Language)
+	}
+	if query.Limit > 0 {
+		values.Set("limit", strconv.Itoa(query.Limit))
+	}
+	if query.Offset > 0 {
+		values.Set("offset", strconv.Itoa(query.Offset))
+	}
+
+	var hits []SearchHit
+	_, err := c.do(ctx, &call{
+		method: http.MethodGet,
+		path:   "/search",
+		query:  values,
+	}, &hits)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return hits, nil
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"github.com/Vy4cheSlave/qna/internal/domain"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+)
+
+// Сущности API совпадают с типами сервиса, поэтому клиент не расходится с сервером.
+type (
+	User           = domain.User
+	Role           = domain.Role
+	Question       = domain.Question
+	QuestionStatus = domain.QuestionStatus
+	QuestionFilter = domain.QuestionFilter
+	TagMatch       = domain.TagMatch
+	Answer         = domain.Answer
+	Comment        = domain.Comment
+	SortOrder      = domain.SortOrder
+	SearchQuery    = domain.SearchQuery
+	SearchHit      = domain.SearchHit
+
+	// Патчи содержат только изменяемые поля; nil означает, что поле не меняется.
+	UserPatch     = domain.UserPatch
+	QuestionPatch = domain.QuestionPatch
+	AnswerPatch   = domain.AnswerPatch
+
+	Tokens = response.TokenResponse
+)
+
+const (
+	RoleUser      = domain.RoleUser
+	RoleModerator = domain.RoleModerator
+	RoleAdmin     = domain.RoleAdmin
+
+	QuestionStatusOpen     = domain.QuestionStatusOpen
+	QuestionStatusAnswered = domain.QuestionStatusAnswered
+	QuestionStatusClosed   = domain.QuestionStatusClosed
+	QuestionStatusLocked   = domain.QuestionStatusLocked
+
+	TagMatchAll = domain.TagMatchAll
+	TagMatchAny = domain.TagMatchAny
+
+	SortAsc  = domain.SortAsc
+	SortDesc = domain.SortDesc
+)
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/request"
+	"github.com/Vy4cheSlave/qna/internal/infrastructure/rest/dto/response"
+	"github.com/pkg/errors"
+)
+
+func (c *Client) CreateUser(ctx context.Context, userName string, password string) (userId string, err error) {
+	const op = "pkg/client/users.Client.CreateUser"
+
+	var resp response.CreateUserResponse
+	_, err = c.do(ctx, &call{
+		method: http.MethodPost,
+		path:   "/users/",
+		body:   request.CreateUserRequest{Name: userName, Password: password},
+	}, &resp)
+	if err != nil {
+		return "", errors.Wrap(err, op)
+	}
+	return resp.UserId, nil
+}
+
+// GetUsers возвращает одну страницу списка пользователей.
+func (c *Client) GetUsers(ctx context.Context, params *ListParams) (*Page[User], error) {
+	const op = "pkg/client/users.Client.GetUsers"
+
+	var users []User
+	meta, err := c.do(ctx, &call{
+		method: http.MethodGet,
+		path:   "/users/",
+		query:  params.values(),
+	}, &users)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &Page[User]{Items: users, NextCursor: nextCursor(meta)}, nil
+}
+
+// Users обходит всех пользователей начиная со страницы params.
+func (c *Client) Users(ctx context.Context, params *ListParams) iter.Seq2[User, error] {
+	return paginate(ctx, params, c.GetUsers)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, userId string, patch *UserPatch) (*User, error) {
+	const op = "pkg/client/users.Client.UpdateUser"
+
+	var user User
+	_, err := c.do(ctx, &call{
+		method:      http.MethodPatch,
+		path:        "/users/" + url.PathEscape(userId),
+		contentType: contentTypeMergePatch,
+		body:        request.PatchUserRequest{Name: optional(patch.Name)},
+	}, &user)
+	if err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	return &user, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, userId string) error {
+	const op = "pkg/client/users.Client.DeleteUser"
+
+	_, err := c.do(ctx, &call{
+		method: http.MethodDelete,
+		path:   "/users/" + url.PathEscape(userId),
+	}, nil)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	return nil
+}
+
+func nextCursor(meta *response.Meta) string {
+	if meta == nil {
+		return ""
+	}
+	return meta.NextCursor
+}
+
+// optional переводит поле патча в поле документа JSON Merge Patch.
+func optional[T any](value *T) request.Optional[T] {
+	if value == nil {
+		return request.Optional[T]{}
+	}
+	return request.Optional[T]{Set: true, Value: *value}
+}